@@ -0,0 +1,188 @@
+// Package export writes Tenable.SC analysis results to CSV and JSON Lines.
+//
+//	Writers consume any Iterator, normally a *tenablesc.AnalysisIterator, so results are streamed
+//	page by page rather than collected in memory first.
+package export
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Iterator is the subset of *tenablesc.AnalysisIterator used by the writers.
+type Iterator interface {
+	Next() bool
+	Value() interface{}
+	Err() error
+}
+
+// column is a leaf field of a result struct, addressed by its dotted json name.
+type column struct {
+	name  string
+	index []int
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Columns returns the column names available for resultType, in struct field order.
+//
+//	Names come from the json tags; nested structs are flattened with dotted names such as `family.name`,
+//	and embedded structs contribute their fields directly, as they do in the JSON encoding.
+func Columns(resultType reflect.Type) ([]string, error) {
+	cols, err := columnsFor(resultType)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(cols))
+	for _, c := range cols {
+		names = append(names, c.name)
+	}
+	return names, nil
+}
+
+func columnsFor(resultType reflect.Type) ([]column, error) {
+	for resultType.Kind() == reflect.Ptr {
+		resultType = resultType.Elem()
+	}
+	if resultType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("export requires a struct result type, got %s", resultType)
+	}
+
+	return appendColumns(nil, resultType, "", nil, map[reflect.Type]bool{}), nil
+}
+
+func appendColumns(cols []column, t reflect.Type, prefix string, index []int, visiting map[reflect.Type]bool) []column {
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		fieldIndex := append(append([]int{}, index...), i)
+		fieldType := f.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if isNested(fieldType) && !visiting[fieldType] {
+			if f.Anonymous && name == "" {
+				cols = appendColumns(cols, fieldType, prefix, fieldIndex, visiting)
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			cols = appendColumns(cols, fieldType, prefix+name+".", fieldIndex, visiting)
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		cols = append(cols, column{name: prefix + name, index: fieldIndex})
+	}
+
+	return cols
+}
+
+// isNested reports whether t should be flattened into its fields rather than written as a single value.
+func isNested(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		return false
+	}
+	if t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType) {
+		return false
+	}
+	return true
+}
+
+// selectColumns picks the named columns, in the order given, or all columns if names is empty.
+func selectColumns(all []column, names []string) ([]column, error) {
+	if len(names) == 0 {
+		return all, nil
+	}
+
+	byName := make(map[string]column, len(all))
+	for _, c := range all {
+		byName[c.name] = c
+	}
+
+	selected := make([]column, 0, len(names))
+	for _, name := range names {
+		c, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown column '%s'", name)
+		}
+		selected = append(selected, c)
+	}
+	return selected, nil
+}
+
+// field walks index from v, returning an invalid Value if a nil pointer is crossed.
+func field(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v
+}
+
+// formatCell renders a leaf value for CSV; values without a natural string form are written as JSON.
+func formatCell(v reflect.Value) (string, error) {
+	for v.IsValid() && v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return "", nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprint(v.Interface()), nil
+	case reflect.Slice, reflect.Map, reflect.Interface:
+		if v.IsNil() {
+			return "", nil
+		}
+	}
+
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		return string(text), err
+	}
+
+	encoded, err := json.Marshal(v.Interface())
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
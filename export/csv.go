@@ -0,0 +1,108 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// CSVWriter writes results of a single type as CSV rows.
+type CSVWriter struct {
+	w          *csv.Writer
+	resultType reflect.Type
+	columns    []column
+}
+
+// NewCSVWriter prepares to write results of resultType to w.
+//
+//	columns selects and orders the output by name as returned by Columns; all columns are written if none are given.
+func NewCSVWriter(w io.Writer, resultType reflect.Type, columns ...string) (*CSVWriter, error) {
+	all, err := columnsFor(resultType)
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := selectColumns(all, columns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select csv columns for %s: %w", resultType, err)
+	}
+
+	return &CSVWriter{
+		w:          csv.NewWriter(w),
+		resultType: resultType,
+		columns:    selected,
+	}, nil
+}
+
+// WriteHeader writes the selected column names.
+func (w *CSVWriter) WriteHeader() error {
+	header := make([]string, 0, len(w.columns))
+	for _, c := range w.columns {
+		header = append(header, c.name)
+	}
+	return w.w.Write(header)
+}
+
+// Write writes result as a row; it must be of the result type given to NewCSVWriter, or a pointer to it.
+func (w *CSVWriter) Write(result interface{}) error {
+	v := reflect.ValueOf(result)
+	if v.Kind() == reflect.Ptr && v.Type().Elem() == w.resultType {
+		if v.IsNil() {
+			return fmt.Errorf("cannot write nil %s", v.Type())
+		}
+		v = v.Elem()
+	}
+	if v.Type() != w.resultType {
+		return fmt.Errorf("expected result of type '%s', got '%T'", w.resultType, result)
+	}
+
+	row := make([]string, 0, len(w.columns))
+	for _, c := range w.columns {
+		cell, err := formatCell(field(v, c.index))
+		if err != nil {
+			return fmt.Errorf("failed to format column %s: %w", c.name, err)
+		}
+		row = append(row, cell)
+	}
+
+	return w.w.Write(row)
+}
+
+// Flush writes any buffered rows and reports any error from this or earlier writes.
+func (w *CSVWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// WriteCSV writes a header and every result of it to w, returning the number of rows written.
+//
+//	resultType is normally it.ResultType() for a *tenablesc.AnalysisIterator.
+func WriteCSV(w io.Writer, it Iterator, resultType reflect.Type, columns ...string) (int, error) {
+	cw, err := NewCSVWriter(w, resultType, columns...)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := cw.WriteHeader(); err != nil {
+		return 0, fmt.Errorf("failed to write csv header: %w", err)
+	}
+
+	count := 0
+	for it.Next() {
+		if err := cw.Write(it.Value()); err != nil {
+			return count, fmt.Errorf("failed to write csv row %d: %w", count+1, err)
+		}
+		count++
+	}
+	if err := it.Err(); err != nil {
+		_ = cw.Flush()
+		return count, err
+	}
+
+	if err := cw.Flush(); err != nil {
+		return count, fmt.Errorf("failed to flush csv: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,90 @@
+package export
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/palantir/tenablesc-client/tenablesc"
+	"github.com/stretchr/testify/assert"
+)
+
+type sliceIterator struct {
+	values []interface{}
+	index  int
+}
+
+func (s *sliceIterator) Next() bool {
+	s.index++
+	return s.index <= len(s.values)
+}
+
+func (s *sliceIterator) Value() interface{} {
+	return s.values[s.index-1]
+}
+
+func (s *sliceIterator) Err() error {
+	return nil
+}
+
+var testResults = []interface{}{
+	tenablesc.VulnIPSummaryResult{
+		Name:     "SSL Certificate Cannot Be Trusted",
+		PluginID: "51192",
+		Family:   tenablesc.VulnFamily{ID: "1", Name: "General"},
+		Severity: tenablesc.BaseInfo{ID: "2", Name: "Medium"},
+		Total:    "3",
+	},
+	tenablesc.VulnIPSummaryResult{
+		Name:     `Name with "quotes", and a comma`,
+		PluginID: "10863",
+		Severity: tenablesc.BaseInfo{ID: "0", Name: "Info"},
+		Total:    "1",
+	},
+}
+
+func TestColumns(t *testing.T) {
+	columns, err := Columns(reflect.TypeOf(tenablesc.VulnIPSummaryResult{}))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []string{"name", "family.id", "family.name", "family.type"}, columns[:4])
+	assert.Contains(t, columns, "hosts.iplist")
+	assert.Contains(t, columns, "severity.name")
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+
+	count, err := WriteCSV(&buf, &sliceIterator{values: testResults}, reflect.TypeOf(tenablesc.VulnIPSummaryResult{}),
+		"pluginID", "name", "severity.name", "family.name")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, 2, count)
+	assert.Equal(t, "pluginID,name,severity.name,family.name\n"+
+		"51192,SSL Certificate Cannot Be Trusted,Medium,General\n"+
+		"10863,\"Name with \"\"quotes\"\", and a comma\",Info,\n", buf.String())
+
+	_, err = WriteCSV(&buf, &sliceIterator{}, reflect.TypeOf(tenablesc.VulnIPSummaryResult{}), "nope")
+	assert.Error(t, err)
+
+	_, err = WriteCSV(&buf, &sliceIterator{values: []interface{}{tenablesc.VulnSumIPResult{}}},
+		reflect.TypeOf(tenablesc.VulnIPSummaryResult{}), "name")
+	assert.Error(t, err)
+}
+
+func TestWriteJSONL(t *testing.T) {
+	var buf bytes.Buffer
+
+	count, err := WriteJSONL(&buf, &sliceIterator{values: testResults[:1]})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, 1, count)
+	assert.Contains(t, buf.String(), `"pluginID":"51192"`)
+	assert.Equal(t, 1, bytes.Count(buf.Bytes(), []byte("\n")))
+}
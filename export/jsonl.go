@@ -0,0 +1,38 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// JSONLWriter writes results as JSON Lines, one object per line, using their json tags.
+type JSONLWriter struct {
+	enc *json.Encoder
+}
+
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return &JSONLWriter{enc: enc}
+}
+
+// Write writes result as a single line.
+func (w *JSONLWriter) Write(result interface{}) error {
+	return w.enc.Encode(result)
+}
+
+// WriteJSONL writes every result of it to w, returning the number of lines written.
+func WriteJSONL(w io.Writer, it Iterator) (int, error) {
+	jw := NewJSONLWriter(w)
+
+	count := 0
+	for it.Next() {
+		if err := jw.Write(it.Value()); err != nil {
+			return count, fmt.Errorf("failed to write jsonl line %d: %w", count+1, err)
+		}
+		count++
+	}
+
+	return count, it.Err()
+}
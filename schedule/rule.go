@@ -0,0 +1,292 @@
+package schedule
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const onceRule = "FREQ=ONCE;INTERVAL=1"
+
+// Frequency is the FREQ component of a repeat rule.
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+)
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+func weekdayCode(d time.Weekday) string {
+	return strings.ToUpper(d.String()[:2])
+}
+
+// Weekday is a BYDAY entry; Ordinal selects the nth (or, when negative, nth from last) such day
+//
+//	of the month for monthly rules, and is zero for every such day.
+type Weekday struct {
+	Ordinal int
+	Day     time.Weekday
+}
+
+func (w Weekday) String() string {
+	if w.Ordinal == 0 {
+		return weekdayCode(w.Day)
+	}
+	return fmt.Sprintf("%d%s", w.Ordinal, weekdayCode(w.Day))
+}
+
+func parseWeekday(s string) (Weekday, error) {
+	if len(s) < 2 {
+		return Weekday{}, fmt.Errorf("invalid BYDAY entry %q", s)
+	}
+
+	code := s[len(s)-2:]
+	day, ok := weekdayCodes[code]
+	if !ok {
+		return Weekday{}, fmt.Errorf("invalid BYDAY weekday %q", s)
+	}
+
+	w := Weekday{Day: day}
+	if prefix := s[:len(s)-2]; prefix != "" {
+		ordinal, err := strconv.Atoi(prefix)
+		if err != nil || ordinal == 0 || ordinal < -5 || ordinal > 5 {
+			return Weekday{}, fmt.Errorf("invalid BYDAY ordinal %q", s)
+		}
+		w.Ordinal = ordinal
+	}
+
+	return w, nil
+}
+
+// RepeatRule is a parsed iCal RRULE as used by SC.
+type RepeatRule struct {
+	Frequency Frequency
+	// Interval is the number of days, weeks or months between runs; zero is treated as one.
+	Interval int
+	// ByDay restricts weekly rules to the given days and selects weekdays for monthly rules.
+	ByDay []Weekday
+	// ByMonthDay selects days of the month for monthly rules; negative days count back from the month end.
+	ByMonthDay []int
+	// WeekStart is the WKST component, used to group weeks for weekly rules with an interval;
+	// nil means Monday, the iCal default.
+	WeekStart *time.Weekday
+}
+
+// weekStart returns the WKST day, defaulting to Monday.
+func (r RepeatRule) weekStart() time.Weekday {
+	if r.WeekStart == nil {
+		return time.Monday
+	}
+	return *r.WeekStart
+}
+
+// ParseRepeatRule parses an SC repeatRule. Empty rules and FREQ=ONCE return a nil rule.
+func ParseRepeatRule(rule string) (*RepeatRule, error) {
+	if strings.TrimSpace(rule) == "" {
+		return nil, nil
+	}
+
+	r := &RepeatRule{Interval: 1}
+
+	for _, part := range strings.Split(strings.TrimPrefix(rule, "RRULE:"), ";") {
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid repeat rule component %q in %q", part, rule)
+		}
+		key, value := strings.ToUpper(kv[0]), kv[1]
+
+		switch key {
+		case "FREQ":
+			if strings.ToUpper(value) == "ONCE" {
+				return nil, nil
+			}
+			r.Frequency = Frequency(strings.ToUpper(value))
+		case "INTERVAL":
+			i, err := strconv.Atoi(value)
+			if err != nil || i < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL %q in %q", value, rule)
+			}
+			r.Interval = i
+		case "BYDAY":
+			for _, d := range strings.Split(value, ",") {
+				w, err := parseWeekday(strings.ToUpper(d))
+				if err != nil {
+					return nil, fmt.Errorf("invalid repeat rule %q: %w", rule, err)
+				}
+				r.ByDay = append(r.ByDay, w)
+			}
+		case "BYMONTHDAY":
+			for _, d := range strings.Split(value, ",") {
+				i, err := strconv.Atoi(d)
+				if err != nil || i == 0 || i < -31 || i > 31 {
+					return nil, fmt.Errorf("invalid BYMONTHDAY %q in %q", d, rule)
+				}
+				r.ByMonthDay = append(r.ByMonthDay, i)
+			}
+		case "WKST":
+			day, ok := weekdayCodes[strings.ToUpper(value)]
+			if !ok {
+				return nil, fmt.Errorf("invalid WKST %q in %q", value, rule)
+			}
+			r.WeekStart = &day
+		default:
+			return nil, fmt.Errorf("unsupported repeat rule component %q in %q", key, rule)
+		}
+	}
+
+	switch r.Frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+	case "":
+		return nil, fmt.Errorf("repeat rule %q has no FREQ", rule)
+	default:
+		return nil, fmt.Errorf("unsupported FREQ %q in %q", r.Frequency, rule)
+	}
+
+	return r, nil
+}
+
+// String renders the rule in SC's repeatRule format.
+func (r RepeatRule) String() string {
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	parts := []string{
+		"FREQ=" + string(r.Frequency),
+		"INTERVAL=" + strconv.Itoa(interval),
+	}
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, d := range r.ByDay {
+			days = append(days, d.String())
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, 0, len(r.ByMonthDay))
+		for _, d := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(d))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+
+	if weekStart := r.weekStart(); weekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayCode(weekStart))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// periodsBetween approximates the number of whole days, weeks or months from start to t,
+//
+//	erring low; it is used to skip ahead when generating runs far after start.
+func (r RepeatRule) periodsBetween(start, t time.Time) int {
+	if !t.After(start) {
+		return 0
+	}
+
+	switch r.Frequency {
+	case FrequencyDaily:
+		return int(t.Sub(start) / (24 * time.Hour))
+	case FrequencyWeekly:
+		return int(t.Sub(start) / (7 * 24 * time.Hour))
+	case FrequencyMonthly:
+		return (t.Year()-start.Year())*12 + int(t.Month()) - int(start.Month()) - 1
+	}
+	return 0
+}
+
+// candidates returns the sorted run times within the period'th day, week or month after start,
+//
+//	at start's time of day. Times before start are included and filtered by the caller.
+func (r RepeatRule) candidates(start time.Time, period int) []time.Time {
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+	}
+
+	var out []time.Time
+
+	switch r.Frequency {
+	case FrequencyDaily:
+		out = append(out, start.AddDate(0, 0, period))
+
+	case FrequencyWeekly:
+		weekStartDay := r.weekStart()
+		offset := (int(start.Weekday()) - int(weekStartDay) + 7) % 7
+		weekStart := start.AddDate(0, 0, period*7-offset)
+
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []Weekday{{Day: start.Weekday()}}
+		}
+		for _, d := range days {
+			delta := (int(d.Day) - int(weekStartDay) + 7) % 7
+			t := weekStart.AddDate(0, 0, delta)
+			out = append(out, at(t.Year(), t.Month(), t.Day()))
+		}
+
+	case FrequencyMonthly:
+		first := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, start.Location()).AddDate(0, period, 0)
+		y, m := first.Year(), first.Month()
+		daysInMonth := first.AddDate(0, 1, -1).Day()
+
+		addDay := func(d int) {
+			if d < 0 {
+				d = daysInMonth + d + 1
+			}
+			if d >= 1 && d <= daysInMonth {
+				out = append(out, at(y, m, d))
+			}
+		}
+
+		switch {
+		case len(r.ByMonthDay) > 0:
+			for _, d := range r.ByMonthDay {
+				addDay(d)
+			}
+		case len(r.ByDay) > 0:
+			for _, w := range r.ByDay {
+				firstMatch := 1 + (int(w.Day)-int(first.Weekday())+7)%7
+				var matches []int
+				for d := firstMatch; d <= daysInMonth; d += 7 {
+					matches = append(matches, d)
+				}
+				switch {
+				case w.Ordinal == 0:
+					for _, d := range matches {
+						addDay(d)
+					}
+				case w.Ordinal > 0 && w.Ordinal <= len(matches):
+					addDay(matches[w.Ordinal-1])
+				case w.Ordinal < 0 && -w.Ordinal <= len(matches):
+					addDay(matches[len(matches)+w.Ordinal])
+				}
+			}
+		default:
+			addDay(start.Day())
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Before(out[j]) })
+
+	return out
+}
@@ -0,0 +1,216 @@
+// Package schedule parses and builds the iCal-style schedules used throughout the Tenable.SC API,
+// such as the start and repeatRule fields of scan, repository and blackout window schedules.
+//
+//	Start values look like `TZID=America/New_York:20230101T000000` and repeat rules like
+//	`FREQ=WEEKLY;INTERVAL=1;BYDAY=MO`; only the subset of RFC 5545 that SC emits is supported.
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	startLayout = "20060102T150405"
+
+	// maxPeriods bounds how far occurrence generation searches for matches,
+	//  so a rule that can never fire (e.g. BYMONTHDAY=31 every 12 months from February) terminates.
+	maxPeriods = 10000
+)
+
+// Schedule is a parsed start time and optional repeat rule.
+type Schedule struct {
+	// Start is the first possible run; its Location is the schedule's timezone.
+	Start time.Time
+	// Rule is nil for schedules that run only once.
+	Rule *RepeatRule
+}
+
+// Parse parses SC's start and repeatRule strings into a Schedule.
+//
+//	An empty repeatRule, or one with FREQ=ONCE, results in a Schedule with no Rule.
+func Parse(start, repeatRule string) (*Schedule, error) {
+	s, err := ParseStart(start)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := ParseRepeatRule(repeatRule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schedule{Start: s, Rule: r}, nil
+}
+
+// ParseStart parses an SC start value such as `TZID=America/New_York:20230101T000000`.
+//
+//	Values without a TZID are interpreted as UTC.
+func ParseStart(start string) (time.Time, error) {
+	loc := time.UTC
+	value := start
+
+	if strings.HasPrefix(start, "TZID=") {
+		parts := strings.SplitN(strings.TrimPrefix(start, "TZID="), ":", 2)
+		if len(parts) != 2 {
+			return time.Time{}, fmt.Errorf("start %q has a TZID but no time", start)
+		}
+		l, err := time.LoadLocation(parts[0])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to load timezone for start %q: %w", start, err)
+		}
+		loc = l
+		value = parts[1]
+	}
+
+	t, err := time.ParseInLocation(startLayout, strings.TrimSuffix(value, "Z"), loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse start %q: %w", start, err)
+	}
+
+	return t, nil
+}
+
+// FormatStart renders t in SC's start format using t's Location as the TZID.
+//
+//	The process-local timezone has no portable name, so Local times are rendered in UTC.
+func FormatStart(t time.Time) string {
+	if t.Location() == time.Local {
+		t = t.UTC()
+	}
+	return fmt.Sprintf("TZID=%s:%s", t.Location().String(), t.Format(startLayout))
+}
+
+// Once returns a schedule that runs a single time at start.
+func Once(start time.Time) Schedule {
+	return Schedule{Start: start}
+}
+
+// Daily returns a schedule that runs every interval days at start's time of day.
+func Daily(start time.Time, interval int) Schedule {
+	return Schedule{Start: start, Rule: &RepeatRule{Frequency: FrequencyDaily, Interval: interval}}
+}
+
+// Weekly returns a schedule that runs every interval weeks on the given days at start's time of day.
+//
+//	If no days are given, the schedule runs on start's weekday.
+func Weekly(start time.Time, interval int, days ...time.Weekday) Schedule {
+	r := &RepeatRule{Frequency: FrequencyWeekly, Interval: interval}
+	for _, d := range days {
+		r.ByDay = append(r.ByDay, Weekday{Day: d})
+	}
+	return Schedule{Start: start, Rule: r}
+}
+
+// MonthlyOnDays returns a schedule that runs every interval months on the given days of the month.
+//
+//	Negative days count back from the end of the month, so -1 is the last day.
+func MonthlyOnDays(start time.Time, interval int, days ...int) Schedule {
+	return Schedule{Start: start, Rule: &RepeatRule{
+		Frequency:  FrequencyMonthly,
+		Interval:   interval,
+		ByMonthDay: days,
+	}}
+}
+
+// MonthlyOnWeekday returns a schedule that runs every interval months on the ordinal'th weekday,
+//
+//	e.g. an ordinal of 2 and time.Tuesday for the second Tuesday, or -1 and time.Friday for the last Friday.
+func MonthlyOnWeekday(start time.Time, interval, ordinal int, day time.Weekday) Schedule {
+	return Schedule{Start: start, Rule: &RepeatRule{
+		Frequency: FrequencyMonthly,
+		Interval:  interval,
+		ByDay:     []Weekday{{Ordinal: ordinal, Day: day}},
+	}}
+}
+
+// StartString renders the schedule start in SC's format.
+func (s Schedule) StartString() string {
+	return FormatStart(s.Start)
+}
+
+// RepeatRuleString renders the schedule repeat rule in SC's format; schedules without a rule render as FREQ=ONCE.
+func (s Schedule) RepeatRuleString() string {
+	if s.Rule == nil {
+		return onceRule
+	}
+	return s.Rule.String()
+}
+
+// Next returns up to n run times strictly after the given time.
+func (s Schedule) Next(after time.Time, n int) []time.Time {
+	var runs []time.Time
+	if n <= 0 {
+		return runs
+	}
+
+	s.each(after, time.Time{}, func(t time.Time) bool {
+		if t.After(after) {
+			runs = append(runs, t)
+		}
+		return len(runs) < n
+	})
+
+	return runs
+}
+
+// Between returns all run times in the half-open interval [from, to).
+func (s Schedule) Between(from, to time.Time) []time.Time {
+	var runs []time.Time
+
+	s.each(from, to, func(t time.Time) bool {
+		if !t.Before(from) {
+			runs = append(runs, t)
+		}
+		return true
+	})
+
+	return runs
+}
+
+// ActiveAt reports whether t falls within a run of the given duration, i.e. [run, run+duration).
+func (s Schedule) ActiveAt(t time.Time, duration time.Duration) bool {
+	return len(s.Between(t.Add(-duration).Add(time.Nanosecond), t.Add(time.Nanosecond))) > 0
+}
+
+// each calls fn with run times in order until fn returns false, a run at or after limit is reached
+//
+//	(when limit is not zero), or the search bound is exhausted. Runs well before from are skipped
+//	without being generated, but fn may still see a few runs before from.
+func (s Schedule) each(from, limit time.Time, fn func(time.Time) bool) {
+	emit := func(t time.Time) bool {
+		if t.Before(s.Start) {
+			return true
+		}
+		if !limit.IsZero() && !t.Before(limit) {
+			return false
+		}
+		return fn(t)
+	}
+
+	if s.Rule == nil {
+		emit(s.Start)
+		return
+	}
+
+	r := s.Rule
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	first := r.periodsBetween(s.Start, from) - 1
+	if first < 0 {
+		first = 0
+	}
+	first -= first % interval
+
+	for period := first; period < first+maxPeriods; period += interval {
+		for _, t := range r.candidates(s.Start, period) {
+			if !emit(t) {
+				return
+			}
+		}
+	}
+}
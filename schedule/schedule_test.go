@@ -0,0 +1,109 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRoundTrip(t *testing.T) {
+	s, err := Parse("TZID=America/New_York:20230101T020000", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "America/New_York", s.Start.Location().String())
+	assert.Equal(t, 2, s.Start.Hour())
+	assert.Equal(t, FrequencyWeekly, s.Rule.Frequency)
+	assert.Equal(t, 2, s.Rule.Interval)
+	assert.Equal(t, []Weekday{{Day: time.Monday}, {Day: time.Wednesday}}, s.Rule.ByDay)
+
+	assert.Equal(t, "TZID=America/New_York:20230101T020000", s.StartString())
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", s.RepeatRuleString())
+
+	once, err := Parse("TZID=UTC:20230101T000000", "FREQ=ONCE;INTERVAL=1")
+	if assert.NoError(t, err) {
+		assert.Nil(t, once.Rule)
+	}
+
+	_, err = Parse("TZID=UTC:20230101T000000", "FREQ=YEARLY")
+	assert.Error(t, err)
+}
+
+func TestNext(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 2, 0, 0, 0, time.UTC) // a Sunday
+
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.January, 9, 2, 0, 0, 0, time.UTC),
+		time.Date(2023, time.January, 11, 2, 0, 0, 0, time.UTC),
+		time.Date(2023, time.January, 23, 2, 0, 0, 0, time.UTC),
+	}, Weekly(start, 2, time.Monday, time.Wednesday).Next(start, 3))
+
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.January, 10, 2, 0, 0, 0, time.UTC),
+		time.Date(2023, time.February, 14, 2, 0, 0, 0, time.UTC),
+	}, MonthlyOnWeekday(start, 1, 2, time.Tuesday).Next(start, 2))
+
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.January, 31, 2, 0, 0, 0, time.UTC),
+		time.Date(2023, time.March, 31, 2, 0, 0, 0, time.UTC),
+	}, MonthlyOnDays(start, 2, -1).Next(start, 2))
+
+	assert.Equal(t, []time.Time{
+		time.Date(2043, time.January, 2, 2, 0, 0, 0, time.UTC),
+	}, Daily(start, 1).Next(time.Date(2043, time.January, 1, 3, 0, 0, 0, time.UTC), 1))
+
+	assert.Empty(t, Once(start).Next(start, 1))
+}
+
+func TestBuiltSchedulesRoundTrip(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 2, 0, 0, 0, time.UTC) // a Sunday
+
+	for _, built := range []Schedule{
+		Daily(start, 3),
+		Weekly(start, 2, time.Monday, time.Wednesday),
+		MonthlyOnDays(start, 2, 1, -1),
+		MonthlyOnWeekday(start, 1, -1, time.Friday),
+	} {
+		rule := built.RepeatRuleString()
+		assert.NotContains(t, rule, "WKST")
+
+		parsed, err := Parse(built.StartString(), rule)
+		if !assert.NoError(t, err, rule) {
+			continue
+		}
+		assert.Equal(t, built.Next(start, 5), parsed.Next(start, 5), rule)
+	}
+}
+
+func TestRepeatRuleLiteralDefaultsToMondayWeekStart(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 2, 0, 0, 0, time.UTC) // a Sunday
+	literal := Schedule{Start: start, Rule: &RepeatRule{
+		Frequency: FrequencyWeekly,
+		Interval:  2,
+		ByDay:     []Weekday{{Day: time.Monday}, {Day: time.Wednesday}},
+	}}
+
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", literal.RepeatRuleString())
+	assert.Equal(t, Weekly(start, 2, time.Monday, time.Wednesday).Next(start, 3), literal.Next(start, 3))
+
+	sunday := time.Sunday
+	literal.Rule.WeekStart = &sunday
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;WKST=SU", literal.RepeatRuleString())
+	// Grouping weeks from Sunday puts start in the same week as the following Monday and Wednesday.
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.January, 2, 2, 0, 0, 0, time.UTC),
+		time.Date(2023, time.January, 4, 2, 0, 0, 0, time.UTC),
+		time.Date(2023, time.January, 16, 2, 0, 0, 0, time.UTC),
+	}, literal.Next(start, 3))
+}
+
+func TestActiveAt(t *testing.T) {
+	s := Daily(time.Date(2023, time.January, 1, 22, 0, 0, 0, time.UTC), 1)
+
+	assert.True(t, s.ActiveAt(time.Date(2023, time.March, 3, 23, 0, 0, 0, time.UTC), 4*time.Hour))
+	assert.True(t, s.ActiveAt(time.Date(2023, time.March, 4, 1, 59, 0, 0, time.UTC), 4*time.Hour))
+	assert.False(t, s.ActiveAt(time.Date(2023, time.March, 4, 2, 0, 0, 0, time.UTC), 4*time.Hour))
+	assert.False(t, s.ActiveAt(time.Date(2022, time.December, 31, 23, 0, 0, 0, time.UTC), 4*time.Hour))
+}
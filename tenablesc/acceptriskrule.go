@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 )
 
 const acceptRiskRuleEndpoint = "/acceptRiskRule"
@@ -21,7 +22,7 @@ type AcceptRiskRuleBaseFields struct {
 	Port         string              `json:"port,omitempty"`
 	Protocol     string              `json:"protocol,omitempty"`
 	Comments     string              `json:"comments,omitempty"`
-	Expires      string              `json:"expires,omitempty"`
+	Expires      *Expiry             `json:"expires,omitempty"`
 	Status       string              `json:"status,omitempty"`
 	CreatedTime  UnixEpochStringTime `json:"createdTime,omitempty"`
 	ModifiedTime UnixEpochStringTime `json:"modifiedTime,omitempty"`
@@ -45,6 +46,10 @@ type AcceptRiskRule struct {
 }
 
 func (a AcceptRiskRule) toInternal() (*acceptRiskRuleInternal, error) {
+	if a.Repository == nil {
+		return nil, errors.New("accept risk rule has no repository")
+	}
+
 	rule := &acceptRiskRuleInternal{
 		AcceptRiskRuleBaseFields: a.AcceptRiskRuleBaseFields,
 		Repositories:             []BaseInfo{*a.Repository},
@@ -91,7 +96,10 @@ func (a acceptRiskRuleInternal) toExternal() (*AcceptRiskRule, error) {
 		}
 		rule.HostValue = string(assetInfo.ID)
 	case "ip", "uuid":
-		rule.HostValue = string(a.HostValue)
+		// Undo the json string encoding applied in toInternal, so values round trip.
+		if err := json.Unmarshal(a.HostValue, &rule.HostValue); err != nil {
+			return nil, fmt.Errorf("could not parse %s hostvalue %s as string", a.HostType, string(a.HostValue))
+		}
 	default:
 		return nil, fmt.Errorf("HostType %s not supported in client", a.HostType)
 	}
@@ -162,3 +170,85 @@ func (c *Client) DeleteAcceptRiskRule(id string) error {
 
 	return nil
 }
+
+// UpdateAcceptRiskRule replaces the fields of an existing accept risk rule, identified by its ID.
+func (c *Client) UpdateAcceptRiskRule(a *AcceptRiskRule) (*AcceptRiskRule, error) {
+	resp := &acceptRiskRuleInternal{}
+
+	aInt, err := a.toInternal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rule to internal format: %w", err)
+	}
+
+	if _, err := c.patchResourceWithID(acceptRiskRuleEndpoint, aInt, resp); err != nil {
+		return nil, fmt.Errorf("failed to update accept risk rule: %w", err)
+	}
+
+	return resp.toExternal()
+}
+
+// ApplyAcceptRiskRules pushes accept risk rules into repository {repositoryID} immediately
+//
+//	rather than waiting for the next import; an empty repositoryID applies rules to all repositories.
+func (c *Client) ApplyAcceptRiskRules(repositoryID string) error {
+	if _, err := c.postResource(fmt.Sprintf("%s/apply", acceptRiskRuleEndpoint), newRiskRuleApplyRequest(repositoryID), nil); err != nil {
+		return fmt.Errorf("failed to apply accept risk rules: %w", err)
+	}
+
+	return nil
+}
+
+// AcceptRiskRuleBulkResult is the outcome of creating a rule for a single target in CreateAcceptRiskRules.
+type AcceptRiskRuleBulkResult struct {
+	Target RiskRuleTarget
+	Rule   *AcceptRiskRule
+	Err    error
+}
+
+// CreateAcceptRiskRules creates one rule per target, copying all other fields from template,
+//
+//	with at most concurrency requests in flight. Results are returned in target order;
+//	failures are reported per target rather than stopping the batch. An error is returned without
+//	creating anything if the template itself is unusable.
+func (c *Client) CreateAcceptRiskRules(template *AcceptRiskRule, targets []RiskRuleTarget, concurrency int) ([]AcceptRiskRuleBulkResult, error) {
+	if template == nil || template.Repository == nil {
+		return nil, errors.New("accept risk rule template has no repository")
+	}
+
+	results := make([]AcceptRiskRuleBulkResult, len(targets))
+
+	forEachConcurrently(len(targets), concurrency, func(i int) {
+		target := targets[i]
+		rule := *template
+		rule.ID = ""
+		rule.Plugin = &BaseInfo{ID: ProbablyString(target.PluginID)}
+		rule.HostType = target.HostType
+		rule.HostValue = target.HostValue
+
+		created, err := c.CreateAcceptRiskRule(&rule)
+		results[i] = AcceptRiskRuleBulkResult{Target: target, Rule: created, Err: err}
+	})
+
+	return results, nil
+}
+
+// GetAcceptRiskRulesExpiringWithin returns the accept risk rules that expire between now and now+window,
+//
+//	e.g. to notify owners before exceptions lapse. Rules that never expire are not included.
+func (c *Client) GetAcceptRiskRulesExpiringWithin(window time.Duration) ([]*AcceptRiskRule, error) {
+	rules, err := c.GetAllAcceptRiskRules()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var expiring []*AcceptRiskRule
+
+	for _, r := range rules {
+		if expiresWithin(r.Expires, now, window) {
+			expiring = append(expiring, r)
+		}
+	}
+
+	return expiring, nil
+}
@@ -0,0 +1,58 @@
+package tenablesc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAcceptRiskRules(t *testing.T) {
+	var requests int32
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		atomic.AddInt32(&requests, 1)
+
+		created := &acceptRiskRuleInternal{}
+		if err := json.NewDecoder(r.Body).Decode(created); err != nil {
+			return err
+		}
+		if created.Plugin.ID == "2" {
+			return errors.New("plugin not found")
+		}
+		created.Repository = &created.Repositories[0]
+		return []*acceptRiskRuleInternal{created}
+	})
+
+	targets := []RiskRuleTarget{
+		{PluginID: "1", HostType: "ip", HostValue: "10.0.0.1"},
+		{PluginID: "2", HostType: "all"},
+	}
+
+	_, err := c.CreateAcceptRiskRules(nil, targets, 2)
+	assert.Error(t, err)
+	_, err = c.CreateAcceptRiskRules(&AcceptRiskRule{}, targets, 2)
+	assert.Error(t, err)
+	assert.Zero(t, atomic.LoadInt32(&requests), "invalid templates must not reach the API")
+
+	template := &AcceptRiskRule{
+		AcceptRiskRuleBaseFields: AcceptRiskRuleBaseFields{ID: "9", Comments: "bulk"},
+		Repository:               &BaseInfo{ID: "5"},
+	}
+	results, err := c.CreateAcceptRiskRules(template, targets, 2)
+	if !assert.NoError(t, err) || !assert.Len(t, results, 2) {
+		return
+	}
+
+	assert.NoError(t, results[0].Err)
+	if assert.NotNil(t, results[0].Rule) {
+		assert.Equal(t, "", results[0].Rule.ID)
+		assert.Equal(t, "bulk", results[0].Rule.Comments)
+		assert.Equal(t, "10.0.0.1", results[0].Rule.HostValue)
+		assert.Equal(t, ProbablyString("5"), results[0].Rule.Repository.ID)
+	}
+	assert.Equal(t, targets[1], results[1].Target)
+	assert.Error(t, results[1].Err)
+}
@@ -21,6 +21,17 @@ type AgentScan struct {
 	Policy        *BaseInfo      `json:"policy,omitempty"`
 }
 
+// withoutReadOnlyFields returns a copy of the agent scan with server-managed fields cleared,
+//
+//	so a fetched agent scan can be submitted as a create request.
+func (s AgentScan) withoutReadOnlyFields() *AgentScan {
+	s.ID = ""
+	if s.Schedule != nil {
+		s.Schedule = s.Schedule.withoutReadOnlyFields()
+	}
+	return &s
+}
+
 type agentScanResponse struct {
 	Manageable []*AgentScan `json:"manageable" tenable:"recurse"`
 	Useable    []*AgentScan `json:"useable" tenable:"recurse"`
@@ -64,10 +75,13 @@ func (c *Client) GetAgentGroupsForScanner(id string) ([]*AgentGroup, error) {
 	return resp, nil
 }
 
+// CreateAgentScan creates a new agent scan; server-managed fields such as ID are not sent,
+//
+//	so an agent scan fetched from the API may be passed directly.
 func (c *Client) CreateAgentScan(s *AgentScan) (*AgentScan, error) {
 	resp := &AgentScan{}
 
-	if _, err := c.postResource(agentScanEndpoint, s, resp); err != nil {
+	if _, err := c.postResource(agentScanEndpoint, s.withoutReadOnlyFields(), resp); err != nil {
 		return nil, fmt.Errorf("failed to create agent scan: %w", err)
 	}
 
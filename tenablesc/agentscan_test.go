@@ -0,0 +1,25 @@
+package tenablesc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgentScanWithoutReadOnlyFields(t *testing.T) {
+	s := AgentScan{
+		BaseInfo:   BaseInfo{ID: "1", Name: "agents"},
+		Repository: BaseInfo{ID: "2"},
+		ScanWindow: "60",
+		Schedule:   &ScanSchedule{ID: "3", Type: ScanScheduleTypeTemplate, NextRun: 1700000000},
+		Policy:     &BaseInfo{ID: "4"},
+	}
+
+	assert.Equal(t, &AgentScan{
+		BaseInfo:   BaseInfo{Name: "agents"},
+		Repository: BaseInfo{ID: "2"},
+		ScanWindow: "60",
+		Schedule:   &ScanSchedule{Type: ScanScheduleTypeTemplate},
+		Policy:     &BaseInfo{ID: "4"},
+	}, s.withoutReadOnlyFields())
+}
@@ -0,0 +1,249 @@
+package tenablesc
+
+import (
+	"fmt"
+)
+
+const alertEndpoint = "/alert"
+
+// Values for AlertAction.Type.
+const (
+	AlertActionEmail        = "email"
+	AlertActionNotification = "notification"
+	AlertActionReport       = "report"
+	AlertActionScan         = "scan"
+	AlertActionSyslog       = "syslog"
+	AlertActionTicket       = "ticket"
+)
+
+// Values for AlertTrigger.Name; which are valid depends on the tool of the alert's query.
+const (
+	AlertTriggerIPCount   = "sumip"
+	AlertTriggerPortCount = "sumport"
+	AlertTriggerVulnCount = "listvuln"
+)
+
+// Alert represents the request/response structure for https://docs.tenable.com/tenablesc/api/Alert.htm
+type Alert struct {
+	BaseInfo
+	// Query is the analysis query evaluated by the alert; generally only its ID need be set.
+	Query *AnalysisQuery `json:"query,omitempty"`
+	// TriggerName, TriggerOperator and TriggerValue are best managed through Trigger and SetTrigger.
+	TriggerName     string `json:"triggerName,omitempty"`
+	TriggerOperator string `json:"triggerOperator,omitempty"`
+	TriggerValue    string `json:"triggerValue,omitempty"`
+	// ExecuteOnEveryTrigger runs actions on every matching evaluation rather than only when the alert first triggers.
+	ExecuteOnEveryTrigger    FakeBool            `json:"executeOnEveryTrigger,omitempty"`
+	Schedule                 *ScanSchedule       `json:"schedule,omitempty"`
+	Action                   []AlertAction       `json:"action,omitempty"`
+	Status                   string              `json:"status,omitempty"`
+	DidTriggerLastEvaluation FakeBool            `json:"didTriggerLastEvaluation,omitempty"`
+	LastTriggered            UnixEpochStringTime `json:"lastTriggered,omitempty"`
+	LastEvaluated            UnixEpochStringTime `json:"lastEvaluated,omitempty"`
+	Owner                    *UserInfo           `json:"owner,omitempty"`
+	OwnerGroup               *BaseInfo           `json:"ownerGroup,omitempty"`
+	CreatedTime              UnixEpochStringTime `json:"createdTime,omitempty"`
+	ModifiedTime             UnixEpochStringTime `json:"modifiedTime,omitempty"`
+}
+
+// AlertTrigger is the condition under which an alert fires, e.g. {AlertTriggerIPCount, ">=", "10"}.
+type AlertTrigger struct {
+	Name string
+	// Operator may be '>=', '<=', '=' or '!='.
+	Operator string
+	Value    string
+}
+
+// Trigger returns the alert's trigger condition.
+func (a Alert) Trigger() AlertTrigger {
+	return AlertTrigger{
+		Name:     a.TriggerName,
+		Operator: a.TriggerOperator,
+		Value:    a.TriggerValue,
+	}
+}
+
+// SetTrigger sets the alert's trigger condition.
+func (a *Alert) SetTrigger(t AlertTrigger) {
+	a.TriggerName = t.Name
+	a.TriggerOperator = t.Operator
+	a.TriggerValue = t.Value
+}
+
+// AlertAction is a single action run when an alert fires.
+type AlertAction struct {
+	ID         ProbablyString        `json:"id,omitempty"`
+	Type       string                `json:"type"`
+	Definition AlertActionDefinition `json:"definition"`
+	Status     string                `json:"status,omitempty"`
+}
+
+// AlertActionDefinition holds the settings for every action type; only the fields for the action's type are used.
+type AlertActionDefinition struct {
+	// Subject, Addresses and IncludeResults are for email actions.
+	Subject        string   `json:"subject,omitempty"`
+	Addresses      string   `json:"addresses,omitempty"`
+	IncludeResults FakeBool `json:"includeResults,omitempty"`
+	// Message is for email, notification and syslog actions.
+	Message string `json:"message,omitempty"`
+	// Users is for email and notification actions.
+	Users []UserInfo `json:"users,omitempty"`
+	// Report is for report actions, referencing a report definition.
+	Report *BaseInfo `json:"report,omitempty"`
+	// Scan is for scan actions.
+	Scan *BaseInfo `json:"scan,omitempty"`
+	// Host, Port and Severity are for syslog actions; Severity may be 'Critical', 'Warning' or 'Notice'.
+	Host     string `json:"host,omitempty"`
+	Port     string `json:"port,omitempty"`
+	Severity string `json:"severity,omitempty"`
+	// Assignee, Name, Description and Notes are for ticket actions.
+	Assignee    *UserInfo `json:"assignee,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Notes       string    `json:"notes,omitempty"`
+}
+
+// NewEmailAlertAction builds an action emailing the given addresses, a comma separated list, and SC users.
+func NewEmailAlertAction(subject, message, addresses string, users []UserInfo, includeResults bool) AlertAction {
+	return AlertAction{
+		Type: AlertActionEmail,
+		Definition: AlertActionDefinition{
+			Subject:        subject,
+			Message:        message,
+			Addresses:      addresses,
+			Users:          users,
+			IncludeResults: ToFakeBool(includeResults),
+		},
+	}
+}
+
+// NewNotificationAlertAction builds an action sending an SC notification to the given users.
+func NewNotificationAlertAction(message string, users []UserInfo) AlertAction {
+	return AlertAction{
+		Type:       AlertActionNotification,
+		Definition: AlertActionDefinition{Message: message, Users: users},
+	}
+}
+
+// NewReportAlertAction builds an action launching report definition {reportDefinitionID}.
+func NewReportAlertAction(reportDefinitionID string) AlertAction {
+	return AlertAction{
+		Type:       AlertActionReport,
+		Definition: AlertActionDefinition{Report: &BaseInfo{ID: ProbablyString(reportDefinitionID)}},
+	}
+}
+
+// NewScanAlertAction builds an action launching scan {scanID}.
+func NewScanAlertAction(scanID string) AlertAction {
+	return AlertAction{
+		Type:       AlertActionScan,
+		Definition: AlertActionDefinition{Scan: &BaseInfo{ID: ProbablyString(scanID)}},
+	}
+}
+
+// NewSyslogAlertAction builds an action sending message to the syslog server at host:port.
+func NewSyslogAlertAction(host, port, severity, message string) AlertAction {
+	return AlertAction{
+		Type: AlertActionSyslog,
+		Definition: AlertActionDefinition{
+			Host:     host,
+			Port:     port,
+			Severity: severity,
+			Message:  message,
+		},
+	}
+}
+
+// NewTicketAlertAction builds an action opening a ticket assigned to user {assigneeID}.
+func NewTicketAlertAction(assigneeID, name, description string) AlertAction {
+	return AlertAction{
+		Type: AlertActionTicket,
+		Definition: AlertActionDefinition{
+			Assignee:    &UserInfo{ID: ProbablyString(assigneeID)},
+			Name:        name,
+			Description: description,
+		},
+	}
+}
+
+type allAlertsResponse struct {
+	Manageable []*Alert `json:"manageable" tenable:"recurse"`
+	Usable     []*Alert `json:"usable" tenable:"recurse"`
+}
+
+func (o allAlertsResponse) allAlertsToExternal() []*Alert {
+	var spOut []*Alert
+	spMap := make(map[ProbablyString]bool)
+
+	for _, o := range o.Usable {
+		spOut = append(spOut, o)
+		spMap[o.ID] = true
+	}
+	for _, o := range o.Manageable {
+		if _, exists := spMap[o.ID]; !exists {
+			spOut = append(spOut, o)
+			spMap[o.ID] = true
+		}
+	}
+
+	return spOut
+}
+
+func (c *Client) GetAllAlerts() ([]*Alert, error) {
+	var resp allAlertsResponse
+
+	if _, err := c.getResource(alertEndpoint, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get alerts: %w", err)
+	}
+
+	return resp.allAlertsToExternal(), nil
+}
+
+func (c *Client) GetAlert(id string) (*Alert, error) {
+	resp := &Alert{}
+
+	if _, err := c.getResource(fmt.Sprintf("%s/%s", alertEndpoint, id), resp); err != nil {
+		return nil, fmt.Errorf("failed to get alert id %s: %w", id, err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) CreateAlert(a *Alert) (*Alert, error) {
+	resp := &Alert{}
+
+	if _, err := c.postResource(alertEndpoint, a, resp); err != nil {
+		return nil, fmt.Errorf("failed to create alert: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) UpdateAlert(a *Alert) (*Alert, error) {
+	resp := &Alert{}
+
+	if _, err := c.patchResourceWithID(alertEndpoint, a, resp); err != nil {
+		return nil, fmt.Errorf("failed to update alert: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) DeleteAlert(id string) error {
+	if _, err := c.deleteResource(fmt.Sprintf("%s/%s", alertEndpoint, id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete alert %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// ExecuteAlert evaluates alert {id} immediately, running its actions if it triggers.
+func (c *Client) ExecuteAlert(id string) (*Alert, error) {
+	resp := &Alert{}
+
+	if _, err := c.postResource(fmt.Sprintf("%s/%s/execute", alertEndpoint, id), nil, resp); err != nil {
+		return nil, fmt.Errorf("failed to execute alert id %s: %w", id, err)
+	}
+
+	return resp, nil
+}
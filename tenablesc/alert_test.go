@@ -0,0 +1,118 @@
+package tenablesc
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertActionSerialization(t *testing.T) {
+	users := []UserInfo{{ID: "3"}}
+
+	for name, tc := range map[string]struct {
+		action   AlertAction
+		expected string
+	}{
+		"email": {
+			action: NewEmailAlertAction("subject", "message", "a@example.com,b@example.com", users, true),
+			expected: `{"type":"email","definition":{"subject":"subject","message":"message",
+				"addresses":"a@example.com,b@example.com","users":[{"id":"3"}],"includeResults":"true"}}`,
+		},
+		"email without results": {
+			action: NewEmailAlertAction("subject", "message", "", nil, false),
+			expected: `{"type":"email","definition":{"subject":"subject","message":"message",
+				"includeResults":"false"}}`,
+		},
+		"notification": {
+			action:   NewNotificationAlertAction("message", users),
+			expected: `{"type":"notification","definition":{"message":"message","users":[{"id":"3"}]}}`,
+		},
+		"report": {
+			action:   NewReportAlertAction("12"),
+			expected: `{"type":"report","definition":{"report":{"id":"12"}}}`,
+		},
+		"scan": {
+			action:   NewScanAlertAction("13"),
+			expected: `{"type":"scan","definition":{"scan":{"id":"13"}}}`,
+		},
+		"syslog": {
+			action: NewSyslogAlertAction("syslog.example.com", "514", "Critical", "message"),
+			expected: `{"type":"syslog","definition":{"host":"syslog.example.com","port":"514",
+				"severity":"Critical","message":"message"}}`,
+		},
+		"ticket": {
+			action: NewTicketAlertAction("4", "name", "description"),
+			expected: `{"type":"ticket","definition":{"assignee":{"id":"4"},"name":"name",
+				"description":"description"}}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			encoded, err := json.Marshal(tc.action)
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, tc.expected, string(encoded))
+			}
+		})
+	}
+}
+
+func TestAlertTriggerSerialization(t *testing.T) {
+	a := Alert{BaseInfo: BaseInfo{Name: "many hosts"}, ExecuteOnEveryTrigger: ToFakeBool(true)}
+	a.SetTrigger(AlertTrigger{Name: AlertTriggerIPCount, Operator: ">=", Value: "10"})
+
+	encoded, err := json.Marshal(a)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"name":"many hosts","triggerName":"sumip","triggerOperator":">=","triggerValue":"10",
+			"executeOnEveryTrigger":"true"}`, string(encoded))
+	}
+
+	var decoded Alert
+	err = json.Unmarshal([]byte(`{"id":"7","triggerName":"listvuln","triggerOperator":"!=","triggerValue":"0",
+		"executeOnEveryTrigger":"false","didTriggerLastEvaluation":"true",
+		"action":[{"id":-1,"type":"scan","definition":{"scan":{"id":0}},"status":"0"}]}`), &decoded)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, AlertTrigger{Name: AlertTriggerVulnCount, Operator: "!=", Value: "0"}, decoded.Trigger())
+	assert.False(t, decoded.ExecuteOnEveryTrigger.AsBool())
+	assert.True(t, decoded.DidTriggerLastEvaluation.AsBool())
+	if assert.Len(t, decoded.Action, 1) {
+		assert.Equal(t, ProbablyString("-1"), decoded.Action[0].ID)
+		assert.Equal(t, ProbablyString("0"), decoded.Action[0].Definition.Scan.ID)
+	}
+}
+
+func TestExecuteAlert(t *testing.T) {
+	var method, path string
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		method, path = r.Method, r.URL.Path
+		return Alert{BaseInfo: BaseInfo{ID: "7"}, DidTriggerLastEvaluation: FakeTrue}
+	})
+
+	alert, err := c.ExecuteAlert("7")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, "/alert/7/execute", path)
+	assert.True(t, alert.DidTriggerLastEvaluation.AsBool())
+}
+
+func TestGetAllAlerts(t *testing.T) {
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		return json.RawMessage(`{
+			"usable":[{"id":"1","name":"usable"},{"id":2,"name":"both"}],
+			"manageable":[{"id":"2","name":"both"},{"id":"3","name":"manageable"}]}`)
+	})
+
+	alerts, err := c.GetAllAlerts()
+	if !assert.NoError(t, err) {
+		return
+	}
+	var names []string
+	for _, a := range alerts {
+		names = append(names, a.Name)
+	}
+	assert.Equal(t, []string{"usable", "both", "manageable"}, names)
+}
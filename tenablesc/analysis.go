@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 const (
@@ -53,6 +54,34 @@ type AnalysisFilter struct {
 	Value interface{} `json:"value"`
 }
 
+// SeverityFilter builds a filter matching findings with any of the given severities.
+//
+//	At least one severity must be given; with none the filter value is empty rather than matching any severity.
+func SeverityFilter(severities ...Severity) AnalysisFilter {
+	ids := make([]string, 0, len(severities))
+	for _, s := range severities {
+		ids = append(ids, string(s))
+	}
+	return AnalysisFilter{
+		FilterName: "severity",
+		Operator:   "=",
+		Value:      strings.Join(ids, ","),
+	}
+}
+
+// MinimumSeverityFilter builds a filter matching findings of the given severity or higher.
+//
+//	minimum should be valid; an invalid minimum ranks below Info, so every severity matches.
+func MinimumSeverityFilter(minimum Severity) AnalysisFilter {
+	var severities []Severity
+	for _, s := range AllSeverities {
+		if s.Compare(minimum) >= 0 {
+			severities = append(severities, s)
+		}
+	}
+	return SeverityFilter(severities...)
+}
+
 // AnalysisResponseContainer is the output structure produced by an Analyze query.
 // Results are further unmarshalled before return from call.
 type AnalysisResponseContainer struct {
@@ -115,6 +144,11 @@ type VulnIPSummaryResult struct {
 	Total             string     `json:"total"`
 }
 
+// SeverityLevel parses the Severity reference.
+func (v VulnIPSummaryResult) SeverityLevel() (Severity, error) {
+	return SeverityFromBaseInfo(v.Severity)
+}
+
 // VulnDetailsResult contains the structure used by the 'vulndetails' analysis tool
 type VulnDetailsResult struct {
 	AcceptRisk          string         `json:"acceptRisk"`
@@ -167,6 +201,11 @@ type VulnDetailsResult struct {
 	XREF                string         `json:"xref"`
 }
 
+// SeverityLevel parses the Severity reference.
+func (v VulnDetailsResult) SeverityLevel() (Severity, error) {
+	return SeverityFromBaseInfo(v.Severity)
+}
+
 // VulnFamily information for a vulnerability
 type VulnFamily struct {
 	ID   string `json:"id"`
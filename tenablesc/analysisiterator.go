@@ -0,0 +1,136 @@
+package tenablesc
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// DefaultAnalysisPageSize is the number of records requested per page when none is given.
+const DefaultAnalysisPageSize = 1000
+
+// AnalysisIterator pages through every result of an Analysis using startOffset and endOffset,
+//
+//	so large result sets can be processed without holding them in memory. Typical use:
+//
+//	it, err := client.NewAnalysisIterator(a, 0)
+//	for it.Next() {
+//		row := it.Value().(VulnDetailsResult)
+//	}
+//	if err := it.Err(); err != nil { ... }
+type AnalysisIterator struct {
+	c        *Client
+	a        Analysis
+	pageSize int
+	elemType reflect.Type
+
+	offset int
+	total  int
+	done   bool
+	page   reflect.Value
+	index  int
+	err    error
+}
+
+// AnalysisResultType returns the element type the given analysis tool produces, e.g. VulnDetailsResult for 'vulndetails'.
+func AnalysisResultType(tool string) (reflect.Type, error) {
+	container, err := (&Client{}).vulnContainerForTool(tool)
+	if err != nil {
+		return nil, fmt.Errorf("tool '%s' unknown to api, cannot render", tool)
+	}
+	return reflect.TypeOf(container).Elem(), nil
+}
+
+// NewAnalysisIterator prepares to page through the results of a, pageSize records at a time.
+//
+//	Any offsets already set on a are ignored. No request is made until the first call to Next.
+func (c *Client) NewAnalysisIterator(a *Analysis, pageSize int) (*AnalysisIterator, error) {
+	elemType, err := AnalysisResultType(a.Query.Tool)
+	if err != nil {
+		return nil, err
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultAnalysisPageSize
+	}
+
+	return &AnalysisIterator{
+		c:        c,
+		a:        *a,
+		pageSize: pageSize,
+		elemType: elemType,
+		total:    -1,
+	}, nil
+}
+
+// ResultType returns the type of the values produced by Value.
+func (it *AnalysisIterator) ResultType() reflect.Type {
+	return it.elemType
+}
+
+// Total returns the total number of matching records reported by SC, or -1 before the first page is fetched.
+func (it *AnalysisIterator) Total() int {
+	return it.total
+}
+
+// Next advances to the next result, fetching another page when needed.
+//
+//	It returns false once results are exhausted or a request fails; check Err afterwards.
+func (it *AnalysisIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+	if it.page.IsValid() && it.index < it.page.Len() {
+		return true
+	}
+	if it.done {
+		return false
+	}
+
+	if err := it.fetch(); err != nil {
+		it.err = err
+		return false
+	}
+
+	return it.index < it.page.Len()
+}
+
+func (it *AnalysisIterator) fetch() error {
+	it.a.StartOffset = strconv.Itoa(it.offset)
+	it.a.EndOffset = strconv.Itoa(it.offset + it.pageSize)
+
+	page := reflect.New(reflect.SliceOf(it.elemType))
+	resp, err := it.c.Analyze(&it.a, page.Interface())
+	if err != nil {
+		return fmt.Errorf("failed to fetch analysis results from offset %d: %w", it.offset, err)
+	}
+
+	if resp.TotalRecords != "" {
+		total, err := strconv.Atoi(resp.TotalRecords)
+		if err != nil {
+			return fmt.Errorf("failed to parse analysis totalRecords '%s': %w", resp.TotalRecords, err)
+		}
+		it.total = total
+	}
+
+	it.page = page.Elem()
+	it.index = 0
+	it.offset += it.page.Len()
+
+	if it.page.Len() < it.pageSize || (it.total >= 0 && it.offset >= it.total) {
+		it.done = true
+	}
+
+	return nil
+}
+
+// Value returns the current result, of the type given by ResultType.
+func (it *AnalysisIterator) Value() interface{} {
+	return it.page.Index(it.index).Interface()
+}
+
+// Err returns the error that stopped iteration, if any.
+func (it *AnalysisIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,88 @@
+package tenablesc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newAnalysisStubClient serves count sumip results, recording the requested offsets.
+//
+//	totalRecords is reported as given, and requests starting at failAt return an error.
+func newAnalysisStubClient(t *testing.T, count int, totalRecords string, failAt int, offsets *[]string) *Client {
+	return newStubClient(t, func(r *http.Request) interface{} {
+		var a Analysis
+		if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+			return err
+		}
+		*offsets = append(*offsets, a.StartOffset+"-"+a.EndOffset)
+
+		start, _ := strconv.Atoi(a.StartOffset)
+		end, _ := strconv.Atoi(a.EndOffset)
+		if start == failAt {
+			return errors.New("analysis unavailable")
+		}
+
+		var results []VulnSumIPResult
+		for i := start; i < end && i < count; i++ {
+			results = append(results, VulnSumIPResult{IP: fmt.Sprintf("10.0.0.%d", i)})
+		}
+		body, _ := json.Marshal(results)
+		return AnalysisResponseContainer{TotalRecords: totalRecords, Results: body}
+	})
+}
+
+func collectAnalysisIPs(it *AnalysisIterator) []string {
+	var ips []string
+	for it.Next() {
+		ips = append(ips, it.Value().(VulnSumIPResult).IP)
+	}
+	return ips
+}
+
+func TestAnalysisIterator(t *testing.T) {
+	a := &Analysis{Type: "vuln", Query: AnalysisQuery{Tool: "sumip"}, StartOffset: "50", EndOffset: "60"}
+
+	// Stops on totalRecords without requesting an empty page.
+	var offsets []string
+	it, err := newAnalysisStubClient(t, 4, "4", -1, &offsets).NewAnalysisIterator(a, 2)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, -1, it.Total())
+	assert.Equal(t, []string{"10.0.0.0", "10.0.0.1", "10.0.0.2", "10.0.0.3"}, collectAnalysisIPs(it))
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 4, it.Total())
+	assert.Equal(t, []string{"0-2", "2-4"}, offsets)
+
+	// Stops on a short page when SC doesn't report a total.
+	offsets = nil
+	it, err = newAnalysisStubClient(t, 3, "", -1, &offsets).NewAnalysisIterator(a, 2)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"10.0.0.0", "10.0.0.1", "10.0.0.2"}, collectAnalysisIPs(it))
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"0-2", "2-4"}, offsets)
+
+	// Request failures stop iteration and are reported by Err.
+	offsets = nil
+	it, err = newAnalysisStubClient(t, 6, "6", 2, &offsets).NewAnalysisIterator(a, 2)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"10.0.0.0", "10.0.0.1"}, collectAnalysisIPs(it))
+	if assert.Error(t, it.Err()) {
+		assert.Contains(t, it.Err().Error(), "offset 2")
+	}
+	assert.False(t, it.Next())
+	assert.Equal(t, []string{"0-2", "2-4"}, offsets)
+
+	_, err = (&Client{}).NewAnalysisIterator(&Analysis{Query: AnalysisQuery{Tool: "unknown"}}, 2)
+	assert.Error(t, err)
+}
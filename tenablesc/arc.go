@@ -0,0 +1,302 @@
+package tenablesc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	arcEndpoint         = "/arc"
+	arcTemplateEndpoint = "/arcTemplate"
+)
+
+// Values for ARCPolicyStatement.DisplayType.
+const (
+	ARCDisplayCount      = "count"
+	ARCDisplayRatio      = "ratio"
+	ARCDisplayPercentage = "percentage"
+)
+
+// ARC represents the request/response structure for https://docs.tenable.com/tenablesc/api/ARC.htm
+//
+//	An Assurance Report Card is a set of policy statements, each a query and a pass/fail threshold,
+//	evaluated within the scope of the focus filters.
+type ARC struct {
+	BaseInfo
+	FocusFilters     []AnalysisFilter     `json:"focusFilters,omitempty"`
+	PolicyStatements []ARCPolicyStatement `json:"policyStatements,omitempty"`
+	Schedule         *ScanSchedule        `json:"schedule,omitempty"`
+	Status           string               `json:"status,omitempty"`
+	Owner            *UserInfo            `json:"owner,omitempty"`
+	OwnerGroup       *BaseInfo            `json:"ownerGroup,omitempty"`
+	CreatedTime      UnixEpochStringTime  `json:"createdTime,omitempty"`
+	ModifiedTime     UnixEpochStringTime  `json:"modifiedTime,omitempty"`
+}
+
+// ARCPolicyStatement is a single pass/fail check within an ARC.
+type ARCPolicyStatement struct {
+	ID    ProbablyString `json:"id,omitempty"`
+	Label string         `json:"label,omitempty"`
+	// Query selects the hosts that count towards the statement.
+	Query          *AnalysisQuery `json:"query,omitempty"`
+	DrilldownQuery *AnalysisQuery `json:"drilldownQuery,omitempty"`
+	// CompareOperator may be '>=', '<=', '>', '<', '=' or '!='.
+	CompareOperator string `json:"compareOperator,omitempty"`
+	// CompareValue is a host count, or a percentage of in-scope hosts for ratio and percentage statements.
+	CompareValue string `json:"compareValue,omitempty"`
+	DisplayType  string `json:"displayType,omitempty"`
+}
+
+// ARCTemplate represents the response structure for https://docs.tenable.com/tenablesc/api/ARC-Template.htm
+type ARCTemplate struct {
+	BaseInfo
+	Summary      string              `json:"summary,omitempty"`
+	Category     *BaseInfo           `json:"category,omitempty"`
+	Enabled      FakeBool            `json:"enabled,omitempty"`
+	CreatedTime  UnixEpochStringTime `json:"createdTime,omitempty"`
+	ModifiedTime UnixEpochStringTime `json:"modifiedTime,omitempty"`
+}
+
+type arcTemplateAddRequest struct {
+	Name         string           `json:"name,omitempty"`
+	FocusFilters []AnalysisFilter `json:"focusFilters,omitempty"`
+}
+
+// ARCStatementResult is the outcome of evaluating a policy statement.
+type ARCStatementResult struct {
+	Statement ARCPolicyStatement
+	// Matched is the number of hosts matching the statement query within the ARC focus.
+	Matched int
+	// Total is the number of hosts within the ARC focus; it is only fetched for ratio and percentage statements.
+	Total int
+	// Value is what was compared with CompareValue: Matched, or Matched as a percentage of Total.
+	Value  float64
+	Passed bool
+}
+
+// IsPercentage reports whether the statement compares a percentage of in-scope hosts rather than a count.
+func (s ARCPolicyStatement) IsPercentage() bool {
+	return s.DisplayType == ARCDisplayRatio || s.DisplayType == ARCDisplayPercentage ||
+		strings.HasSuffix(strings.TrimSpace(s.CompareValue), "%")
+}
+
+// Evaluate applies the statement's threshold to matched of total in-scope hosts.
+func (s ARCPolicyStatement) Evaluate(matched, total int) (*ARCStatementResult, error) {
+	threshold, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(s.CompareValue), "%"), 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse compare value of policy statement '%s': %w", s.Label, err)
+	}
+
+	result := &ARCStatementResult{
+		Statement: s,
+		Matched:   matched,
+		Total:     total,
+		Value:     float64(matched),
+	}
+	if s.IsPercentage() {
+		result.Value = percent(matched, total)
+	}
+
+	switch s.CompareOperator {
+	case ">=":
+		result.Passed = result.Value >= threshold
+	case "<=":
+		result.Passed = result.Value <= threshold
+	case ">":
+		result.Passed = result.Value > threshold
+	case "<":
+		result.Passed = result.Value < threshold
+	case "=", "==":
+		result.Passed = result.Value == threshold
+	case "!=":
+		result.Passed = result.Value != threshold
+	default:
+		return nil, fmt.Errorf("policy statement '%s' has unsupported compare operator '%s'", s.Label, s.CompareOperator)
+	}
+
+	return result, nil
+}
+
+type allARCsResponse struct {
+	Manageable []*ARC `json:"manageable" tenable:"recurse"`
+	Usable     []*ARC `json:"usable" tenable:"recurse"`
+}
+
+func (o allARCsResponse) allARCsToExternal() []*ARC {
+	var spOut []*ARC
+	spMap := make(map[ProbablyString]bool)
+
+	for _, o := range o.Usable {
+		spOut = append(spOut, o)
+		spMap[o.ID] = true
+	}
+	for _, o := range o.Manageable {
+		if _, exists := spMap[o.ID]; !exists {
+			spOut = append(spOut, o)
+			spMap[o.ID] = true
+		}
+	}
+
+	return spOut
+}
+
+func (c *Client) GetAllARCs() ([]*ARC, error) {
+	var resp allARCsResponse
+
+	if _, err := c.getResource(arcEndpoint, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get arcs: %w", err)
+	}
+
+	return resp.allARCsToExternal(), nil
+}
+
+func (c *Client) GetARC(id string) (*ARC, error) {
+	resp := &ARC{}
+
+	if _, err := c.getResource(fmt.Sprintf("%s/%s", arcEndpoint, id), resp); err != nil {
+		return nil, fmt.Errorf("failed to get arc id %s: %w", id, err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) CreateARC(a *ARC) (*ARC, error) {
+	resp := &ARC{}
+
+	if _, err := c.postResource(arcEndpoint, a, resp); err != nil {
+		return nil, fmt.Errorf("failed to create arc: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) UpdateARC(a *ARC) (*ARC, error) {
+	resp := &ARC{}
+
+	if _, err := c.patchResourceWithID(arcEndpoint, a, resp); err != nil {
+		return nil, fmt.Errorf("failed to update arc: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) DeleteARC(id string) error {
+	if _, err := c.deleteResource(fmt.Sprintf("%s/%s", arcEndpoint, id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete arc %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// GetAllARCTemplates lists the ARC templates available from the Tenable template library.
+func (c *Client) GetAllARCTemplates() ([]*ARCTemplate, error) {
+	var resp []*ARCTemplate
+
+	if _, err := c.getResource(arcTemplateEndpoint, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get arc templates: %w", err)
+	}
+
+	return resp, nil
+}
+
+// AddARCFromTemplate creates an ARC from library template {templateID}, scoped by focusFilters.
+//
+//	name may be empty to keep the template's name.
+func (c *Client) AddARCFromTemplate(templateID, name string, focusFilters []AnalysisFilter) (*ARC, error) {
+	req := &arcTemplateAddRequest{Name: name, FocusFilters: focusFilters}
+	resp := &ARC{}
+
+	if _, err := c.postResource(fmt.Sprintf("%s/%s/add", arcTemplateEndpoint, templateID), req, resp); err != nil {
+		return nil, fmt.Errorf("failed to add arc from template %s: %w", templateID, err)
+	}
+
+	return resp, nil
+}
+
+// evaluationFilters returns the inline filters of the statement query.
+//
+//	Statements that only reference a saved query by ID cannot be evaluated, as the client cannot resolve
+//	saved queries; running them without filters would match every host in focus. Only cumulative vuln queries
+//	are supported, so statements over other data sets are rejected rather than counted against the wrong one.
+//	The query tool is not used, as statements are always evaluated by counting hosts.
+func (s ARCPolicyStatement) evaluationFilters() ([]AnalysisFilter, error) {
+	if s.Query == nil || len(s.Query.Filters) == 0 {
+		return nil, fmt.Errorf("policy statement '%s' has no inline query filters and cannot be evaluated", s.Label)
+	}
+	if s.Query.Type != "" && s.Query.Type != "vuln" {
+		return nil, fmt.Errorf("policy statement '%s' has query type %s, only vuln queries can be evaluated", s.Label, s.Query.Type)
+	}
+	if s.Query.SourceType != "" && s.Query.SourceType != "cumulative" {
+		return nil, fmt.Errorf("policy statement '%s' has query source %s, only cumulative queries can be evaluated", s.Label, s.Query.SourceType)
+	}
+	return s.Query.Filters, nil
+}
+
+// countARCHosts returns the number of hosts matching filters within the ARC focus; filters may be nil to count the focus alone.
+func (c *Client) countARCHosts(a *ARC, filters []AnalysisFilter) (int, error) {
+	var results []VulnSumIPResult
+	resp, err := c.Analyze(&Analysis{
+		Type:       "vuln",
+		SourceType: "cumulative",
+		Query: AnalysisQuery{
+			Type:       "vuln",
+			SourceType: "cumulative",
+			Tool:       "sumip",
+			Filters:    append(append([]AnalysisFilter{}, a.FocusFilters...), filters...),
+		},
+		StartOffset: "0",
+		EndOffset:   "1",
+	}, &results)
+	if err != nil {
+		return 0, err
+	}
+
+	// SC may leave the total empty when nothing matches.
+	if resp.TotalRecords == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(resp.TotalRecords)
+}
+
+// EvaluateARC evaluates every policy statement of ARC {id} against current cumulative data.
+//
+//	Statements are evaluated by counting hosts, as SC does. Statement queries are run inline rather than
+//	by saved query ID, so an error is returned before any query runs if a statement has no inline filters.
+func (c *Client) EvaluateARC(id string) ([]ARCStatementResult, error) {
+	a, err := c.GetARC(id)
+	if err != nil {
+		return nil, err
+	}
+
+	statementFilters := make([][]AnalysisFilter, len(a.PolicyStatements))
+	for i, s := range a.PolicyStatements {
+		if statementFilters[i], err = s.evaluationFilters(); err != nil {
+			return nil, fmt.Errorf("failed to evaluate arc %s: %w", id, err)
+		}
+	}
+
+	total := -1
+	var results []ARCStatementResult
+
+	for i, s := range a.PolicyStatements {
+		matched, err := c.countARCHosts(a, statementFilters[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate policy statement '%s' of arc %s: %w", s.Label, id, err)
+		}
+
+		if s.IsPercentage() && total < 0 {
+			if total, err = c.countARCHosts(a, nil); err != nil {
+				return nil, fmt.Errorf("failed to count hosts in focus of arc %s: %w", id, err)
+			}
+		}
+
+		result, err := s.Evaluate(matched, max(total, 0))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,110 @@
+package tenablesc
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestARCPolicyStatementEvaluate(t *testing.T) {
+	count := ARCPolicyStatement{Label: "no critical hosts", CompareOperator: "=", CompareValue: "0", DisplayType: ARCDisplayCount}
+
+	result, err := count.Evaluate(0, 0)
+	if assert.NoError(t, err) {
+		assert.True(t, result.Passed)
+	}
+	result, err = count.Evaluate(3, 0)
+	if assert.NoError(t, err) {
+		assert.False(t, result.Passed)
+		assert.Equal(t, 3.0, result.Value)
+	}
+
+	ratio := ARCPolicyStatement{Label: "credentialed scans", CompareOperator: ">=", CompareValue: "90%", DisplayType: ARCDisplayRatio}
+
+	result, err = ratio.Evaluate(45, 50)
+	if assert.NoError(t, err) {
+		assert.True(t, result.Passed)
+		assert.Equal(t, 90.0, result.Value)
+	}
+	result, err = ratio.Evaluate(44, 50)
+	if assert.NoError(t, err) {
+		assert.False(t, result.Passed)
+	}
+
+	_, err = ARCPolicyStatement{CompareOperator: "~", CompareValue: "1"}.Evaluate(1, 1)
+	assert.Error(t, err)
+	_, err = ARCPolicyStatement{CompareOperator: ">=", CompareValue: "most"}.Evaluate(1, 1)
+	assert.Error(t, err)
+}
+
+func TestEvaluateARC(t *testing.T) {
+	statements := []ARCPolicyStatement{
+		{Label: "inline", Query: &AnalysisQuery{Filters: []AnalysisFilter{SeverityFilter(SeverityCritical)}}, CompareOperator: "=", CompareValue: "0"},
+	}
+	var analyzed []Analysis
+
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		if r.URL.Path == analysisEndpoint {
+			var a Analysis
+			_ = json.NewDecoder(r.Body).Decode(&a)
+			analyzed = append(analyzed, a)
+			return AnalysisResponseContainer{TotalRecords: "10", Results: json.RawMessage("[]")}
+		}
+		return ARC{
+			BaseInfo:         BaseInfo{ID: "1"},
+			FocusFilters:     []AnalysisFilter{{FilterName: "repository", Operator: "=", Value: "1"}},
+			PolicyStatements: statements,
+		}
+	})
+
+	results, err := c.EvaluateARC("1")
+	if assert.NoError(t, err) && assert.Len(t, results, 1) {
+		assert.Equal(t, 10, results[0].Matched)
+		assert.False(t, results[0].Passed)
+	}
+	if assert.Len(t, analyzed, 1) {
+		assert.Equal(t, []string{"repository", "severity"},
+			[]string{analyzed[0].Query.Filters[0].FilterName, analyzed[0].Query.Filters[1].FilterName})
+	}
+
+	// A statement referencing a saved query only would match every host in focus; nothing should run.
+	statements = append(statements, ARCPolicyStatement{Label: "saved", Query: &AnalysisQuery{ID: "42"}, CompareOperator: "=", CompareValue: "0"})
+	analyzed = nil
+
+	_, err = c.EvaluateARC("1")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "'saved'")
+	}
+	assert.Empty(t, analyzed)
+
+	_, err = ARCPolicyStatement{Label: "none"}.evaluationFilters()
+	assert.Error(t, err)
+}
+
+func TestARCPolicyStatementEvaluationFilters(t *testing.T) {
+	filters := []AnalysisFilter{SeverityFilter(SeverityCritical)}
+
+	got, err := ARCPolicyStatement{Label: "untyped", Query: &AnalysisQuery{Filters: filters}}.evaluationFilters()
+	assert.NoError(t, err)
+	assert.Equal(t, filters, got)
+
+	_, err = ARCPolicyStatement{Label: "cumulative", Query: &AnalysisQuery{Type: "vuln", SourceType: "cumulative", Tool: "vulndetails", Filters: filters}}.evaluationFilters()
+	assert.NoError(t, err)
+
+	_, err = ARCPolicyStatement{Label: "mobile", Query: &AnalysisQuery{Type: "mobile", Filters: filters}}.evaluationFilters()
+	assert.Error(t, err)
+	_, err = ARCPolicyStatement{Label: "patched", Query: &AnalysisQuery{Type: "vuln", SourceType: "patched", Filters: filters}}.evaluationFilters()
+	assert.Error(t, err)
+}
+
+func TestCountARCHostsEmptyTotal(t *testing.T) {
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		return AnalysisResponseContainer{Results: json.RawMessage("[]")}
+	})
+
+	count, err := c.countARCHosts(&ARC{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
@@ -89,3 +89,84 @@ func (s UnixEpochStringTime) ToDateTime() (time.Time, error) {
 	}
 	return time.Unix(i, 0), nil
 }
+
+// AsInt parses the value as a base 10 integer; empty values are treated as zero.
+func (p ProbablyString) AsInt() (int, error) {
+	if p == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(string(p))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse int: %w", err)
+	}
+	return i, nil
+}
+
+// AsTime parses the value as unix epoch seconds.
+//
+//	SC reports times that have not happened yet as empty, 0 or -1; these are returned as the zero time.
+func (p ProbablyString) AsTime() (time.Time, error) {
+	i, err := p.AsInt()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse time: %w", err)
+	}
+	if i <= 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(int64(i), 0), nil
+}
+
+// AsDuration parses the value as a number of seconds; empty and negative values are treated as zero.
+func (p ProbablyString) AsDuration() (time.Duration, error) {
+	i, err := p.AsInt()
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if i < 0 {
+		return 0, nil
+	}
+	return time.Duration(i) * time.Second, nil
+}
+
+// typedFieldParser accumulates the first parse failure while converting a series of stringy API fields,
+//
+//	so typed views can be built without checking an error after every field.
+type typedFieldParser struct {
+	err error
+}
+
+func (t *typedFieldParser) int(name string, p ProbablyString) int {
+	i, err := p.AsInt()
+	if err != nil && t.err == nil {
+		t.err = fmt.Errorf("field %s: %w", name, err)
+	}
+	return i
+}
+
+func (t *typedFieldParser) time(name string, p ProbablyString) time.Time {
+	v, err := p.AsTime()
+	if err != nil && t.err == nil {
+		t.err = fmt.Errorf("field %s: %w", name, err)
+	}
+	return v
+}
+
+func (t *typedFieldParser) duration(name string, p ProbablyString) time.Duration {
+	d, err := p.AsDuration()
+	if err != nil && t.err == nil {
+		t.err = fmt.Errorf("field %s: %w", name, err)
+	}
+	return d
+}
+
+// percent returns completed as a percentage of total, or zero when total is unknown.
+func percent(completed, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	p := float64(completed) / float64(total) * 100
+	if p > 100 {
+		return 100
+	}
+	return p
+}
@@ -0,0 +1,56 @@
+package tenablesc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbablyStringAsInt(t *testing.T) {
+	for input, want := range map[ProbablyString]int{"": 0, "0": 0, "42": 42, "-1": -1} {
+		got, err := input.AsInt()
+		if assert.NoError(t, err, input) {
+			assert.Equal(t, want, got, input)
+		}
+	}
+
+	_, err := ProbablyString("4.5").AsInt()
+	assert.Error(t, err)
+}
+
+func TestProbablyStringAsTime(t *testing.T) {
+	for _, input := range []ProbablyString{"", "0", "-1"} {
+		got, err := input.AsTime()
+		if assert.NoError(t, err, input) {
+			assert.True(t, got.IsZero(), input)
+		}
+	}
+
+	got, err := ProbablyString("1700000000").AsTime()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1700000000, 0), got)
+
+	_, err = ProbablyString("yesterday").AsTime()
+	assert.Error(t, err)
+}
+
+func TestProbablyStringAsDuration(t *testing.T) {
+	for input, want := range map[ProbablyString]time.Duration{"": 0, "-1": 0, "90": 90 * time.Second} {
+		got, err := input.AsDuration()
+		if assert.NoError(t, err, input) {
+			assert.Equal(t, want, got, input)
+		}
+	}
+
+	_, err := ProbablyString("1m").AsDuration()
+	assert.Error(t, err)
+}
+
+func TestPercent(t *testing.T) {
+	assert.Equal(t, 0.0, percent(5, 0))
+	assert.Equal(t, 0.0, percent(5, -1))
+	assert.Equal(t, 25.0, percent(1, 4))
+	assert.Equal(t, 100.0, percent(4, 4))
+	assert.Equal(t, 100.0, percent(5, 4))
+}
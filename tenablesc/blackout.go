@@ -0,0 +1,267 @@
+package tenablesc
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/palantir/tenablesc-client/schedule"
+)
+
+const blackoutEndpoint = "/blackout"
+
+// BlackoutWindow represents the request/response structure for https://docs.tenable.com/tenablesc/api/Blackout-Window.htm
+//
+//	Newer SC releases present these as "freeze windows" in the UI; the API is the same.
+type BlackoutWindow struct {
+	BaseInfo
+	// AllIPs blacks out every target; when false, IPList and Assets define the scope.
+	AllIPs FakeBool `json:"allIPs,omitempty"`
+	// IPList is a comma or newline separated list of IPs, CIDRs and dash ranges.
+	IPList string     `json:"ipList,omitempty"`
+	Assets []BaseInfo `json:"assets,omitempty"`
+	// Start and RepeatRule use the iCal formats understood by the schedule package.
+	Start      string `json:"start,omitempty"`
+	RepeatRule string `json:"repeatRule,omitempty"`
+	// Duration is the length of each blackout in hours.
+	Duration     ProbablyString      `json:"duration,omitempty"`
+	Enabled      FakeBool            `json:"enabled,omitempty"`
+	Active       FakeBool            `json:"active,omitempty"`
+	Functional   FakeBool            `json:"functional,omitempty"`
+	Owner        *UserInfo           `json:"owner,omitempty"`
+	CreatedTime  UnixEpochStringTime `json:"createdTime,omitempty"`
+	ModifiedTime UnixEpochStringTime `json:"modifiedTime,omitempty"`
+}
+
+// SetSchedule sets Start, RepeatRule and Duration from a parsed schedule.
+//
+//	SC records durations in hours, so duration must be a positive whole number of hours.
+func (b *BlackoutWindow) SetSchedule(s schedule.Schedule, duration time.Duration) error {
+	if duration < time.Hour || duration%time.Hour != 0 {
+		return fmt.Errorf("blackout window duration %s is not a whole number of hours", duration)
+	}
+
+	b.Start = s.StartString()
+	b.RepeatRule = s.RepeatRuleString()
+	b.Duration = ProbablyString(fmt.Sprintf("%d", int(duration/time.Hour)))
+	return nil
+}
+
+// ToSchedule parses Start and RepeatRule.
+func (b BlackoutWindow) ToSchedule() (*schedule.Schedule, error) {
+	return schedule.Parse(b.Start, b.RepeatRule)
+}
+
+// ActiveAt reports whether the window is enabled and in effect at t.
+func (b BlackoutWindow) ActiveAt(t time.Time) (bool, error) {
+	if !b.Enabled.AsBool() {
+		return false, nil
+	}
+
+	s, err := b.ToSchedule()
+	if err != nil {
+		return false, fmt.Errorf("failed to parse blackout window %s schedule: %w", b.ID, err)
+	}
+
+	hours, err := b.Duration.AsInt()
+	if err != nil {
+		return false, fmt.Errorf("failed to parse blackout window %s duration: %w", b.ID, err)
+	}
+
+	return s.ActiveAt(t, time.Duration(hours)*time.Hour), nil
+}
+
+// CoversIP reports whether ip is within the window's scope.
+//
+//	Asset scope cannot be resolved from the window alone; assetIPs should hold the defined IPs of the window's Assets.
+func (b BlackoutWindow) CoversIP(ip net.IP, assetIPs []string) (bool, error) {
+	if b.AllIPs.AsBool() {
+		return true, nil
+	}
+
+	for _, list := range append([]string{b.IPList}, assetIPs...) {
+		covered, err := ipListContains(list, ip)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse blackout window %s scope: %w", b.ID, err)
+		}
+		if covered {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ipListContains checks ip against an SC-style list of IPs, CIDRs and dash ranges separated by commas or whitespace.
+func ipListContains(list string, ip net.IP) (bool, error) {
+	entries := strings.FieldsFunc(list, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n' || r == '\r' || r == '\t'
+	})
+
+	for _, entry := range entries {
+		switch {
+		case strings.Contains(entry, "/"):
+			_, network, err := net.ParseCIDR(entry)
+			if err != nil {
+				return false, fmt.Errorf("invalid CIDR %s: %w", entry, err)
+			}
+			if network.Contains(ip) {
+				return true, nil
+			}
+		case strings.Contains(entry, "-"):
+			bounds := strings.SplitN(entry, "-", 2)
+			low, high := net.ParseIP(bounds[0]), net.ParseIP(bounds[1])
+			if low == nil || high == nil {
+				return false, fmt.Errorf("invalid IP range %s", entry)
+			}
+			if ipInRange(ip, low, high) {
+				return true, nil
+			}
+		default:
+			single := net.ParseIP(entry)
+			if single == nil {
+				return false, fmt.Errorf("invalid IP %s", entry)
+			}
+			if single.Equal(ip) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// blackoutAssetIPs returns the IP list of a static asset. Other asset types are resolved by SC at scan time,
+//
+//	so an error is returned rather than an empty list that would wrongly exclude every target.
+func blackoutAssetIPs(asset *Asset) (string, error) {
+	if asset.Type != "static" {
+		return "", fmt.Errorf("cannot resolve the IPs of %s asset %s", asset.Type, asset.ID)
+	}
+	return strings.Join(asset.DefinedIPs, ","), nil
+}
+
+func ipInRange(ip, low, high net.IP) bool {
+	if v4 := ip.To4(); v4 != nil {
+		low, high, ip = low.To4(), high.To4(), v4
+		if low == nil || high == nil {
+			return false
+		}
+	} else {
+		low, high, ip = low.To16(), high.To16(), ip.To16()
+	}
+	return bytes.Compare(ip, low) >= 0 && bytes.Compare(ip, high) <= 0
+}
+
+func (c *Client) GetAllBlackoutWindows() ([]*BlackoutWindow, error) {
+	var resp []*BlackoutWindow
+
+	if _, err := c.getResource(blackoutEndpoint, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get blackout windows: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) GetBlackoutWindow(id string) (*BlackoutWindow, error) {
+	resp := &BlackoutWindow{}
+
+	if _, err := c.getResource(fmt.Sprintf("%s/%s", blackoutEndpoint, id), resp); err != nil {
+		return nil, fmt.Errorf("failed to get blackout window id %s: %w", id, err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) CreateBlackoutWindow(b *BlackoutWindow) (*BlackoutWindow, error) {
+	resp := &BlackoutWindow{}
+
+	if _, err := c.postResource(blackoutEndpoint, b, resp); err != nil {
+		return nil, fmt.Errorf("failed to create blackout window: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) UpdateBlackoutWindow(b *BlackoutWindow) (*BlackoutWindow, error) {
+	resp := &BlackoutWindow{}
+
+	if _, err := c.patchResourceWithID(blackoutEndpoint, b, resp); err != nil {
+		return nil, fmt.Errorf("failed to update blackout window: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) DeleteBlackoutWindow(id string) error {
+	if _, err := c.deleteResource(fmt.Sprintf("%s/%s", blackoutEndpoint, id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete blackout window %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// GetActiveBlackoutWindows returns the blackout windows in effect at t whose scope includes target,
+//
+//	which must be an IP address. Asset-scoped windows are resolved by looking up each asset's defined IPs;
+//	windows scoped to assets whose membership cannot be resolved that way, such as dynamic or DNS assets,
+//	return an error rather than being treated as not covering target.
+func (c *Client) GetActiveBlackoutWindows(t time.Time, target string) ([]*BlackoutWindow, error) {
+	ip := net.ParseIP(target)
+	if ip == nil {
+		return nil, fmt.Errorf("target %s is not an IP address", target)
+	}
+
+	windows, err := c.GetAllBlackoutWindows()
+	if err != nil {
+		return nil, err
+	}
+
+	var active []*BlackoutWindow
+
+	for _, w := range windows {
+		inEffect, err := w.ActiveAt(t)
+		if err != nil {
+			return nil, err
+		}
+		if !inEffect {
+			continue
+		}
+
+		var assetIPs []string
+		if !w.AllIPs.AsBool() {
+			for _, a := range w.Assets {
+				asset, err := c.GetAsset(string(a.ID))
+				if err != nil {
+					return nil, fmt.Errorf("failed to resolve blackout window %s asset: %w", w.ID, err)
+				}
+				ips, err := blackoutAssetIPs(asset)
+				if err != nil {
+					return nil, fmt.Errorf("failed to resolve blackout window %s asset: %w", w.ID, err)
+				}
+				assetIPs = append(assetIPs, ips)
+			}
+		}
+
+		covered, err := w.CoversIP(ip, assetIPs)
+		if err != nil {
+			return nil, err
+		}
+		if covered {
+			active = append(active, w)
+		}
+	}
+
+	return active, nil
+}
+
+// IsBlackedOut reports whether any blackout window prevents scanning target at t.
+func (c *Client) IsBlackedOut(t time.Time, target string) (bool, error) {
+	active, err := c.GetActiveBlackoutWindows(t, target)
+	if err != nil {
+		return false, err
+	}
+	return len(active) > 0, nil
+}
@@ -0,0 +1,119 @@
+package tenablesc
+
+import (
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/palantir/tenablesc-client/schedule"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPListContains(t *testing.T) {
+	list := "10.0.0.1, 192.168.1.0/24\n172.16.0.10-172.16.0.20 2001:db8::/32"
+
+	for ip, want := range map[string]bool{
+		"10.0.0.1":      true,
+		"10.0.0.2":      false,
+		"192.168.1.200": true,
+		"192.168.2.1":   false,
+		"172.16.0.10":   true,
+		"172.16.0.20":   true,
+		"172.16.0.21":   false,
+		"2001:db8::1":   true,
+		"2001:db9::1":   false,
+	} {
+		got, err := ipListContains(list, net.ParseIP(ip))
+		if assert.NoError(t, err, ip) {
+			assert.Equal(t, want, got, ip)
+		}
+	}
+
+	for _, bad := range []string{"10.0.0.300", "10.0.0.0/33", "10.0.0.1-nope"} {
+		_, err := ipListContains(bad, net.ParseIP("10.0.0.1"))
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestIPInRange(t *testing.T) {
+	low, high := net.ParseIP("10.0.0.10"), net.ParseIP("10.0.1.5")
+
+	assert.True(t, ipInRange(net.ParseIP("10.0.0.255"), low, high))
+	assert.False(t, ipInRange(net.ParseIP("10.0.1.6"), low, high))
+	// Mixed address families never match.
+	assert.False(t, ipInRange(net.ParseIP("::ffff:10.0.0.11").To16(), net.ParseIP("2001:db8::1"), net.ParseIP("2001:db8::9")))
+	assert.False(t, ipInRange(net.ParseIP("10.0.0.11"), net.ParseIP("2001:db8::1"), net.ParseIP("2001:db8::9")))
+}
+
+func TestBlackoutWindowCoversIP(t *testing.T) {
+	ip := net.ParseIP("10.0.0.5")
+
+	covered, err := BlackoutWindow{AllIPs: FakeTrue}.CoversIP(ip, nil)
+	if assert.NoError(t, err) {
+		assert.True(t, covered)
+	}
+
+	covered, err = BlackoutWindow{IPList: "10.0.1.0/24"}.CoversIP(ip, []string{"10.0.0.1-10.0.0.9"})
+	if assert.NoError(t, err) {
+		assert.True(t, covered)
+	}
+
+	covered, err = BlackoutWindow{IPList: "10.0.1.0/24"}.CoversIP(ip, nil)
+	if assert.NoError(t, err) {
+		assert.False(t, covered)
+	}
+}
+
+func TestBlackoutWindowActiveAt(t *testing.T) {
+	w := BlackoutWindow{Enabled: FakeTrue}
+	if !assert.NoError(t, w.SetSchedule(schedule.Daily(time.Date(2023, time.January, 1, 22, 0, 0, 0, time.UTC), 1), 4*time.Hour)) {
+		return
+	}
+	assert.Equal(t, ProbablyString("4"), w.Duration)
+
+	active, err := w.ActiveAt(time.Date(2023, time.June, 1, 23, 30, 0, 0, time.UTC))
+	if assert.NoError(t, err) {
+		assert.True(t, active)
+	}
+	active, err = w.ActiveAt(time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC))
+	if assert.NoError(t, err) {
+		assert.False(t, active)
+	}
+
+	w.Enabled = FakeFalse
+	active, err = w.ActiveAt(time.Date(2023, time.June, 1, 23, 30, 0, 0, time.UTC))
+	if assert.NoError(t, err) {
+		assert.False(t, active)
+	}
+}
+
+func TestBlackoutWindowSetScheduleRejectsPartialHours(t *testing.T) {
+	s := schedule.Daily(time.Date(2023, time.January, 1, 22, 0, 0, 0, time.UTC), 1)
+
+	w := BlackoutWindow{}
+	assert.Error(t, w.SetSchedule(s, 30*time.Minute))
+	assert.Error(t, w.SetSchedule(s, 90*time.Minute))
+	assert.Empty(t, w.Start)
+}
+
+func TestIsBlackedOutFailsClosedOnUnresolvableAssets(t *testing.T) {
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		if r.URL.Path == assetsEndpoint+"/7" {
+			return Asset{BaseInfo: BaseInfo{ID: "7"}, Type: "dynamic"}
+		}
+		return []BlackoutWindow{{
+			BaseInfo:   BaseInfo{ID: "1"},
+			Assets:     []BaseInfo{{ID: "7"}},
+			Start:      "TZID=UTC:20230101T000000",
+			RepeatRule: "FREQ=DAILY;INTERVAL=1",
+			Duration:   "24",
+			Enabled:    FakeTrue,
+		}}
+	})
+
+	_, err := c.IsBlackedOut(time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC), "10.0.0.5")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "dynamic asset 7")
+	}
+}
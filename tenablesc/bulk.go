@@ -0,0 +1,34 @@
+package tenablesc
+
+import (
+	"sync"
+)
+
+// forEachConcurrently calls fn for every index in [0, n) with at most concurrency calls in flight,
+//
+//	returning once all calls have finished. Values of concurrency below 1 mean 1.
+func forEachConcurrently(n, concurrency int, fn func(i int)) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	work := make(chan int)
+	wg := sync.WaitGroup{}
+
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		work <- i
+	}
+	close(work)
+
+	wg.Wait()
+}
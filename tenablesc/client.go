@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"sort"
@@ -14,6 +15,9 @@ import (
 
 const (
 	DefaultUserAgent = "tenable.sc go client"
+
+	// maxStreamErrorBodySize bounds how much of a failed streaming response is kept for the error.
+	maxStreamErrorBodySize = 64 * 1024
 )
 
 type Client struct {
@@ -140,13 +144,17 @@ func getFieldsForStruct(d interface{}) []string {
 // Generalized handlers for all endpoint queries.
 
 func (c *Client) getResource(endpoint string, dest interface{}) (*response, error) {
+	return c.getResourceWithFields(endpoint, getFieldsForStruct(dest), dest)
+}
+
+// getResourceWithFields is getResource with an explicit list of fields to request, for partial responses.
+func (c *Client) getResourceWithFields(endpoint string, f []string, dest interface{}) (*response, error) {
 	if !isPTR(dest) {
 		return nil, errors.New("provide a pointer to the data source")
 	}
 
 	req := c.client.NewRequest()
 
-	f := getFieldsForStruct(dest)
 	if len(f) > 0 {
 		req.SetQueryParam("fields",
 			strings.Join(f, ","))
@@ -196,6 +204,56 @@ func (c *Client) deleteResource(endpoint string, input interface{}, dest interfa
 	return c.handleRequest(resty.MethodDelete, endpoint, req, dest)
 }
 
+// streamResource executes a request whose response is a file rather than an SCResponse,
+//
+//	copying the body to w without buffering it in memory.
+//	JSON responses are checked for SC errors, since SC reports failures to produce a file that way.
+func (c *Client) streamResource(method, endpoint string, input interface{}, w io.Writer) (*response, error) {
+	req := c.client.NewRequest().SetDoNotParseResponse(true)
+	if input != nil {
+		req.SetBody(input)
+	}
+
+	resp, err := req.Execute(method, endpoint)
+	if err != nil {
+		return &response{resp}, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	body := resp.RawBody()
+	defer func() {
+		_ = body.Close()
+	}()
+
+	if resp.StatusCode() < 200 || resp.StatusCode() > 299 {
+		errBody, _ := io.ReadAll(io.LimitReader(body, maxStreamErrorBodySize))
+		return &response{resp}, httpErrorForStatus(resp.StatusCode(), errBody)
+	}
+
+	if strings.HasPrefix(resp.Header().Get("Content-Type"), "application/json") {
+		jsonBody, err := io.ReadAll(body)
+		if err != nil {
+			return &response{resp}, fmt.Errorf("failed to read response: %w", err)
+		}
+		scr := &SCResponse{}
+		if err := json.Unmarshal(jsonBody, scr); err == nil && scr.ErrorCode != 0 {
+			return &response{resp}, SCError{
+				baseError:   baseError{message: scr.ErrorMsg},
+				SCErrorCode: scr.ErrorCode,
+			}
+		}
+		if _, err := w.Write(jsonBody); err != nil {
+			return &response{resp}, fmt.Errorf("failed to write response: %w", err)
+		}
+		return &response{resp}, nil
+	}
+
+	if _, err := io.Copy(w, body); err != nil {
+		return &response{resp}, fmt.Errorf("failed to copy response: %w", err)
+	}
+
+	return &response{resp}, nil
+}
+
 // handleRequest implements the application-side retry and backoff logic for all queries, retrying in case of
 //
 //	application-side errors that are clearly transient.
@@ -230,19 +288,23 @@ type SCResponse struct {
 }
 
 func handleHTTPError(resp *resty.Response) error {
+	return httpErrorForStatus(resp.StatusCode(), resp.Body())
+}
+
+func httpErrorForStatus(statusCode int, body []byte) error {
 	var respErr error
-	if resp.StatusCode() < 200 || resp.StatusCode() > 299 {
+	if statusCode < 200 || statusCode > 299 {
 
 		httpErr := HTTPError{
 			baseError: baseError{
 				message: "unexpected response from server",
 			},
-			ResponseCode: resp.StatusCode(),
-			Body:         string(resp.Body()),
+			ResponseCode: statusCode,
+			Body:         string(body),
 		}
 
 		//SC's version of not found for some reason.
-		if resp.StatusCode() == 403 {
+		if statusCode == 403 {
 			e := NotFoundError(httpErr)
 			e.baseError.parent = httpErr
 			respErr = e
@@ -1,6 +1,9 @@
 package tenablesc
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -27,3 +30,27 @@ func TestGetFields(t *testing.T) {
 	assert.Equal(t, fields, getFieldsForStruct([]testGetFieldsStruct{}))
 
 }
+
+// newStubClient returns a client for a test server that wraps whatever handle returns in an SC response envelope;
+//
+//	returning an error produces an SC error response instead.
+func newStubClient(t *testing.T, handle func(r *http.Request) interface{}) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		result := handle(r)
+		if err, ok := result.(error); ok {
+			_ = json.NewEncoder(w).Encode(SCResponse{ErrorCode: 1, ErrorMsg: err.Error()})
+			return
+		}
+
+		body, err := json.Marshal(result)
+		if err != nil {
+			t.Errorf("failed to encode stub response: %v", err)
+		}
+		_ = json.NewEncoder(w).Encode(SCResponse{Response: body})
+	}))
+	t.Cleanup(server.Close)
+
+	return NewClient(server.URL)
+}
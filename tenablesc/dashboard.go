@@ -0,0 +1,302 @@
+package tenablesc
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	dashboardEndpoint          = "/dashboard"
+	dashboardComponentEndpoint = "/dashboard/%s/component"
+)
+
+// Values for DashboardComponent.Type. Trends are line or area charts whose data source is queried over time.
+const (
+	DashboardComponentTable     = "table"
+	DashboardComponentBarChart  = "barChart"
+	DashboardComponentPieChart  = "pieChart"
+	DashboardComponentLineChart = "lineChart"
+	DashboardComponentAreaChart = "areaChart"
+	DashboardComponentMatrix    = "matrix"
+)
+
+// Dashboard represents the request/response structure for https://docs.tenable.com/tenablesc/api/Dashboard-Tab.htm
+type Dashboard struct {
+	BaseInfo
+	// Order is the position of the dashboard tab.
+	Order               ProbablyString       `json:"order,omitempty"`
+	Status              string               `json:"status,omitempty"`
+	DashboardComponents []DashboardComponent `json:"dashboardComponents,omitempty"`
+	Owner               *UserInfo            `json:"owner,omitempty"`
+	OwnerGroup          *BaseInfo            `json:"ownerGroup,omitempty"`
+	CreatedTime         UnixEpochStringTime  `json:"createdTime,omitempty"`
+	ModifiedTime        UnixEpochStringTime  `json:"modifiedTime,omitempty"`
+}
+
+// DashboardComponent represents the request/response structure for https://docs.tenable.com/tenablesc/api/Dashboard-Component.htm
+type DashboardComponent struct {
+	BaseInfo
+	DashboardID ProbablyString                `json:"dashboardID,omitempty"`
+	Type        string                        `json:"type,omitempty"`
+	Definition  *DashboardComponentDefinition `json:"definition,omitempty"`
+	// Column and Order position the component within the dashboard layout.
+	Column ProbablyString `json:"column,omitempty"`
+	Order  ProbablyString `json:"order,omitempty"`
+	// RefreshFrequency is in minutes.
+	RefreshFrequency ProbablyString      `json:"refreshFrequency,omitempty"`
+	CreatedTime      UnixEpochStringTime `json:"createdTime,omitempty"`
+	ModifiedTime     UnixEpochStringTime `json:"modifiedTime,omitempty"`
+}
+
+// DashboardComponentDefinition holds the settings for every component type; only the fields for the component's type are used.
+type DashboardComponentDefinition struct {
+	// DataSource is used by table, chart and trend components.
+	DataSource *ReportDataSource `json:"dataSource,omitempty"`
+	// Columns, SortColumn, SortDirection and MaxResults are for table components.
+	Columns       []BaseInfo `json:"columns,omitempty"`
+	SortColumn    string     `json:"sortColumn,omitempty"`
+	SortDirection string     `json:"sortDirection,omitempty"`
+	MaxResults    string     `json:"maxResults,omitempty"`
+	// XAxis, YAxis and DataPoints are for chart components; trends use XAxis 'time'.
+	XAxis      string `json:"xAxis,omitempty"`
+	YAxis      string `json:"yAxis,omitempty"`
+	DataPoints string `json:"dataPoints,omitempty"`
+	// Matrix is for matrix components.
+	Matrix *DashboardMatrix `json:"matrix,omitempty"`
+}
+
+// DashboardMatrix is a grid whose cells each query the intersection of a row and a column.
+type DashboardMatrix struct {
+	Rows    []DashboardMatrixHeader `json:"rows,omitempty"`
+	Columns []DashboardMatrixHeader `json:"columns,omitempty"`
+	// Cells is indexed by row, then column.
+	Cells [][]DashboardMatrixCell `json:"cells,omitempty"`
+}
+
+// DashboardMatrixHeader labels a matrix row or column; its filters apply to every cell in it.
+type DashboardMatrixHeader struct {
+	Name    string           `json:"name,omitempty"`
+	Filters []AnalysisFilter `json:"filters,omitempty"`
+}
+
+// DashboardMatrixCell is a single matrix cell; the first matching rule decides how its result is displayed.
+type DashboardMatrixCell struct {
+	DataSource *ReportDataSource         `json:"dataSource,omitempty"`
+	Rules      []DashboardMatrixCellRule `json:"rules,omitempty"`
+}
+
+// DashboardMatrixCellRule displays a cell with Color and Display when its result compares to Value with Operator.
+type DashboardMatrixCellRule struct {
+	// Operator may be e.g. '>=', '<=' or '='.
+	Operator string         `json:"operator,omitempty"`
+	Value    ProbablyString `json:"value,omitempty"`
+	// Display may be e.g. 'count', 'ratio', 'percentage' or fixed text.
+	Display string `json:"display,omitempty"`
+	// Color is a hex RGB value without the leading '#'.
+	Color string `json:"color,omitempty"`
+}
+
+// NewDashboardTableComponent builds a table component listing the results of query.
+func NewDashboardTableComponent(name string, query AnalysisQuery, columns []BaseInfo, maxResults int) DashboardComponent {
+	return DashboardComponent{
+		BaseInfo: BaseInfo{Name: name},
+		Type:     DashboardComponentTable,
+		Definition: &DashboardComponentDefinition{
+			DataSource: dashboardDataSource(query),
+			Columns:    columns,
+			MaxResults: fmt.Sprintf("%d", maxResults),
+		},
+	}
+}
+
+// NewDashboardChartComponent builds a bar, pie, line or area chart of query, plotting yAxis against xAxis.
+func NewDashboardChartComponent(name, chartType string, query AnalysisQuery, xAxis, yAxis string) DashboardComponent {
+	return DashboardComponent{
+		BaseInfo: BaseInfo{Name: name},
+		Type:     chartType,
+		Definition: &DashboardComponentDefinition{
+			DataSource: dashboardDataSource(query),
+			XAxis:      xAxis,
+			YAxis:      yAxis,
+		},
+	}
+}
+
+// NewDashboardTrendComponent builds a line or area chart plotting yAxis of query over time,
+//
+//	with dataPoints samples along the time axis.
+func NewDashboardTrendComponent(name, chartType string, query AnalysisQuery, yAxis string, dataPoints int) DashboardComponent {
+	return DashboardComponent{
+		BaseInfo: BaseInfo{Name: name},
+		Type:     chartType,
+		Definition: &DashboardComponentDefinition{
+			DataSource: dashboardDataSource(query),
+			XAxis:      "time",
+			YAxis:      yAxis,
+			DataPoints: fmt.Sprintf("%d", dataPoints),
+		},
+	}
+}
+
+func dashboardDataSource(query AnalysisQuery) *ReportDataSource {
+	source := &ReportDataSource{
+		QueryType:       query.Type,
+		QuerySourceType: query.SourceType,
+	}
+	if query.ID != "" {
+		source.QueryID = ProbablyString(query.ID)
+	} else {
+		source.Query = &query
+	}
+	return source
+}
+
+type dashboardImportRequest struct {
+	Name     string `json:"name"`
+	Filename string `json:"filename"`
+}
+
+type allDashboardsResponse struct {
+	Manageable []*Dashboard `json:"manageable" tenable:"recurse"`
+	Usable     []*Dashboard `json:"usable" tenable:"recurse"`
+}
+
+func (o allDashboardsResponse) allDashboardsToExternal() []*Dashboard {
+	var spOut []*Dashboard
+	spMap := make(map[ProbablyString]bool)
+
+	for _, o := range o.Usable {
+		spOut = append(spOut, o)
+		spMap[o.ID] = true
+	}
+	for _, o := range o.Manageable {
+		if _, exists := spMap[o.ID]; !exists {
+			spOut = append(spOut, o)
+			spMap[o.ID] = true
+		}
+	}
+
+	return spOut
+}
+
+func (c *Client) GetAllDashboards() ([]*Dashboard, error) {
+	var resp allDashboardsResponse
+
+	if _, err := c.getResource(dashboardEndpoint, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get dashboards: %w", err)
+	}
+
+	return resp.allDashboardsToExternal(), nil
+}
+
+func (c *Client) GetDashboard(id string) (*Dashboard, error) {
+	resp := &Dashboard{}
+
+	if _, err := c.getResource(fmt.Sprintf("%s/%s", dashboardEndpoint, id), resp); err != nil {
+		return nil, fmt.Errorf("failed to get dashboard id %s: %w", id, err)
+	}
+
+	return resp, nil
+}
+
+// CreateDashboard creates an empty dashboard; components are added with CreateDashboardComponent.
+func (c *Client) CreateDashboard(d *Dashboard) (*Dashboard, error) {
+	resp := &Dashboard{}
+
+	if _, err := c.postResource(dashboardEndpoint, d, resp); err != nil {
+		return nil, fmt.Errorf("failed to create dashboard: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) UpdateDashboard(d *Dashboard) (*Dashboard, error) {
+	resp := &Dashboard{}
+
+	if _, err := c.patchResourceWithID(dashboardEndpoint, d, resp); err != nil {
+		return nil, fmt.Errorf("failed to update dashboard: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) DeleteDashboard(id string) error {
+	if _, err := c.deleteResource(fmt.Sprintf("%s/%s", dashboardEndpoint, id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete dashboard %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// ExportDashboard streams the XML template of dashboard {id}, including its components, to w.
+func (c *Client) ExportDashboard(id string, w io.Writer) error {
+	if _, err := c.streamResource(resty.MethodGet, fmt.Sprintf("%s/%s/export", dashboardEndpoint, id), nil, w); err != nil {
+		return fmt.Errorf("failed to export dashboard %s: %w", id, err)
+	}
+	return nil
+}
+
+// ImportDashboard creates a dashboard named name from a previously uploaded XML template.
+//
+//	filename is the server-side name returned by UploadFile.
+func (c *Client) ImportDashboard(name, filename string) (*Dashboard, error) {
+	resp := &Dashboard{}
+
+	if _, err := c.postResource(fmt.Sprintf("%s/import", dashboardEndpoint), &dashboardImportRequest{Name: name, Filename: filename}, resp); err != nil {
+		return nil, fmt.Errorf("failed to import dashboard %s from %s: %w", name, filename, err)
+	}
+
+	return resp, nil
+}
+
+// ImportDashboardFromReader composes the UploadFileFromReader and ImportDashboard calls necessary
+//
+//	to create a dashboard from an XML template.
+func (c *Client) ImportDashboardFromReader(name string, reader io.Reader) (*Dashboard, error) {
+	file, err := c.UploadFileFromReader(reader, name+".xml", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload file for dashboard import: %w", err)
+	}
+
+	return c.ImportDashboard(name, file.Filename)
+}
+
+func (c *Client) GetDashboardComponent(dashboardID, id string) (*DashboardComponent, error) {
+	resp := &DashboardComponent{}
+
+	if _, err := c.getResource(fmt.Sprintf(dashboardComponentEndpoint+"/%s", dashboardID, id), resp); err != nil {
+		return nil, fmt.Errorf("failed to get component %s of dashboard %s: %w", id, dashboardID, err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) CreateDashboardComponent(dashboardID string, dc *DashboardComponent) (*DashboardComponent, error) {
+	resp := &DashboardComponent{}
+
+	if _, err := c.postResource(fmt.Sprintf(dashboardComponentEndpoint, dashboardID), dc, resp); err != nil {
+		return nil, fmt.Errorf("failed to create component in dashboard %s: %w", dashboardID, err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) UpdateDashboardComponent(dashboardID string, dc *DashboardComponent) (*DashboardComponent, error) {
+	resp := &DashboardComponent{}
+
+	if _, err := c.patchResourceWithID(fmt.Sprintf(dashboardComponentEndpoint, dashboardID), dc, resp); err != nil {
+		return nil, fmt.Errorf("failed to update component in dashboard %s: %w", dashboardID, err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) DeleteDashboardComponent(dashboardID, id string) error {
+	if _, err := c.deleteResource(fmt.Sprintf(dashboardComponentEndpoint+"/%s", dashboardID, id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete component %s of dashboard %s: %w", id, dashboardID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package tenablesc
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDashboardDataSource(t *testing.T) {
+	saved := AnalysisQuery{ID: "7", Type: "vuln", SourceType: "cumulative", Tool: "sumip"}
+	assert.Equal(t, &ReportDataSource{
+		QueryID:         "7",
+		QueryType:       "vuln",
+		QuerySourceType: "cumulative",
+	}, dashboardDataSource(saved))
+
+	inline := AnalysisQuery{Type: "vuln", SourceType: "cumulative", Tool: "sumip", Filters: []AnalysisFilter{SeverityFilter(SeverityCritical)}}
+	source := dashboardDataSource(inline)
+	assert.Empty(t, source.QueryID)
+	assert.Equal(t, "vuln", source.QueryType)
+	assert.Equal(t, "cumulative", source.QuerySourceType)
+	assert.Equal(t, &inline, source.Query)
+}
+
+func TestNewDashboardComponents(t *testing.T) {
+	query := AnalysisQuery{ID: "7", Type: "vuln", SourceType: "cumulative"}
+	columns := []BaseInfo{{Name: "ip"}, {Name: "score"}}
+
+	table := NewDashboardTableComponent("hosts", query, columns, 10)
+	assert.Equal(t, "hosts", table.Name)
+	assert.Equal(t, DashboardComponentTable, table.Type)
+	assert.Equal(t, columns, table.Definition.Columns)
+	assert.Equal(t, "10", table.Definition.MaxResults)
+	assert.Equal(t, ProbablyString("7"), table.Definition.DataSource.QueryID)
+
+	chart := NewDashboardChartComponent("by severity", DashboardComponentBarChart, query, "severity", "total")
+	assert.Equal(t, DashboardComponentBarChart, chart.Type)
+	assert.Equal(t, "severity", chart.Definition.XAxis)
+	assert.Equal(t, "total", chart.Definition.YAxis)
+	assert.Empty(t, chart.Definition.DataPoints)
+
+	trend := NewDashboardTrendComponent("over time", DashboardComponentLineChart, query, "total", 30)
+	assert.Equal(t, DashboardComponentLineChart, trend.Type)
+	assert.Equal(t, "time", trend.Definition.XAxis)
+	assert.Equal(t, "total", trend.Definition.YAxis)
+	assert.Equal(t, "30", trend.Definition.DataPoints)
+	assert.Equal(t, ProbablyString("7"), trend.Definition.DataSource.QueryID)
+}
+
+func TestDashboardMatrixRoundTrip(t *testing.T) {
+	input := `{"rows":[{"name":"Critical","filters":[{"filterName":"severity","operator":"=","value":"4"}]}],` +
+		`"columns":[{"name":"Exploitable","filters":[{"filterName":"exploitAvailable","operator":"=","value":"true"}]}],` +
+		`"cells":[[{"rules":[{"operator":">=","value":"1","display":"count","color":"ff0000"},{"operator":"=","value":0,"display":"count","color":"00ff00"}]}]]}`
+
+	var m DashboardMatrix
+	if !assert.NoError(t, json.Unmarshal([]byte(input), &m)) {
+		return
+	}
+
+	assert.Equal(t, "Critical", m.Rows[0].Name)
+	assert.Equal(t, "severity", m.Rows[0].Filters[0].FilterName)
+	assert.Equal(t, "Exploitable", m.Columns[0].Name)
+	if assert.Len(t, m.Cells, 1) && assert.Len(t, m.Cells[0], 1) {
+		assert.Equal(t, []DashboardMatrixCellRule{
+			{Operator: ">=", Value: "1", Display: "count", Color: "ff0000"},
+			{Operator: "=", Value: "0", Display: "count", Color: "00ff00"},
+		}, m.Cells[0][0].Rules)
+	}
+}
+
+func TestImportDashboard(t *testing.T) {
+	var path string
+	var body map[string]interface{}
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		path = r.Method + " " + r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return err
+		}
+		return Dashboard{BaseInfo: BaseInfo{ID: "8", Name: "imported"}}
+	})
+
+	d, err := c.ImportDashboard("imported", "upload_abc.xml")
+	if assert.NoError(t, err) {
+		assert.Equal(t, ProbablyString("8"), d.ID)
+	}
+	assert.Equal(t, "POST "+dashboardEndpoint+"/import", path)
+	assert.Equal(t, map[string]interface{}{"name": "imported", "filename": "upload_abc.xml"}, body)
+}
@@ -2,10 +2,22 @@ package tenablesc
 
 import (
 	"fmt"
+	"time"
 )
 
 const jobEndpoint = "/job"
 
+// JobStatus is the state of a background job as reported by SC.
+type JobStatus string
+
+const (
+	JobStatusQueued   JobStatus = "Queued"
+	JobStatusRunning  JobStatus = "Running"
+	JobStatusFinished JobStatus = "Finished"
+	JobStatusError    JobStatus = "Error"
+	JobStatusKilled   JobStatus = "Killed"
+)
+
 // Job represents the response structure for https://docs.tenable.com/tenablesc/api/Job.htm
 type Job struct {
 	BaseInfo
@@ -20,11 +32,38 @@ type Job struct {
 	Pid            ProbablyString `json:"pid,omitempty"`
 	Priority       ProbablyString `json:"priority,omitempty"`
 	StartTime      ProbablyString `json:"startTime,omitempty"`
-	Status         string         `json:"status,omitempty"`
+	Status         JobStatus      `json:"status,omitempty"`
 	TargetedTime   ProbablyString `json:"targetedTime,omitempty"`
 	Type           string         `json:"type,omitempty"`
 }
 
+// JobProgress is a typed view of the status and timing fields of a Job.
+type JobProgress struct {
+	Status        JobStatus
+	StartTime     time.Time
+	TargetedTime  time.Time
+	AttemptNumber int
+	Priority      int
+}
+
+// Progress parses the stringy status and timing fields into a JobProgress.
+func (j Job) Progress() (*JobProgress, error) {
+	p := typedFieldParser{}
+
+	progress := &JobProgress{
+		Status:        j.Status,
+		StartTime:     p.time("startTime", j.StartTime),
+		TargetedTime:  p.time("targetedTime", j.TargetedTime),
+		AttemptNumber: p.int("attemptNumber", j.AttemptNumber),
+		Priority:      p.int("priority", j.Priority),
+	}
+	if p.err != nil {
+		return nil, fmt.Errorf("failed to parse job %s: %w", j.ID, p.err)
+	}
+
+	return progress, nil
+}
+
 func (c *Client) GetAllJobs() ([]*Job, error) {
 
 	var jobs []*Job
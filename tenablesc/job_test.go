@@ -0,0 +1,31 @@
+package tenablesc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobProgress(t *testing.T) {
+	progress, err := Job{
+		Status:        JobStatusRunning,
+		StartTime:     "1700000000",
+		TargetedTime:  "-1",
+		AttemptNumber: "2",
+		Priority:      "",
+	}.Progress()
+	if assert.NoError(t, err) {
+		assert.Equal(t, &JobProgress{
+			Status:        JobStatusRunning,
+			StartTime:     time.Unix(1700000000, 0),
+			AttemptNumber: 2,
+		}, progress)
+	}
+
+	_, err = Job{BaseInfo: BaseInfo{ID: "3"}, AttemptNumber: "first"}.Progress()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "job 3")
+		assert.Contains(t, err.Error(), "attemptNumber")
+	}
+}
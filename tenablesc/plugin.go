@@ -1,25 +1,219 @@
 package tenablesc
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
-const pluginEndpoint = "/plugin"
+const (
+	pluginEndpoint = "/plugin"
+
+	// defaultPluginPageSize is the number of plugins requested per page when none is given.
+	defaultPluginPageSize = 1000
+	// maxPluginSearchPages stops searchAllPlugins should SC keep returning full pages of new plugins.
+	maxPluginSearchPages = 10000
+)
+
+// Values for PluginSearch.FilterField. Xrefs of a single type are filtered with 'xrefs:TYPE', e.g. 'xrefs:CVE'.
+const (
+	PluginFilterName          = "name"
+	PluginFilterFamily        = "family"
+	PluginFilterFamilyID      = "familyID"
+	PluginFilterCopyright     = "copyright"
+	PluginFilterXrefs         = "xrefs"
+	PluginFilterCVE           = "xrefs:CVE"
+	PluginFilterModifiedTime  = "modifiedTime"
+	PluginFilterPluginPubDate = "pluginPubDate"
+	PluginFilterPluginModDate = "pluginModDate"
+	PluginFilterPatchPubDate  = "patchPubDate"
+	PluginFilterVulnPubDate   = "vulnPubDate"
+)
+
+// Values for PluginSearch.Type.
+const (
+	PluginTypeAll     = "all"
+	PluginTypeActive  = "active"
+	PluginTypePassive = "passive"
+	PluginTypeLCE     = "lce"
+)
 
 // Plugin represents the response structure for https://docs.tenable.com/tenablesc/api/Plugin.htm
+//
+//	Many fields are delimited lists; XrefList, CVEs, BIDs, CPEs, SeeAlsoURLs, DependencyList and Dates parse them.
 type Plugin struct {
 	BaseInfo
-	Family              Family         `json:"family,omitempty"`
-	CVSSV3BaseScore     string         `json:"cvssV3BaseScore,omitempty"`
-	CVSSV3TemporalScore string         `json:"cvssV3TemporalScore,omitempty"`
-	PluginPubDate       ProbablyString `json:"pluginPubDate,omitempty"`
-	PluginModDate       ProbablyString `json:"pluginModDate,omitempty"`
-	PatchPubDate        ProbablyString `json:"patchPubDate,omitempty"`
-	PatchModDate        ProbablyString `json:"patchModDate,omitempty"`
-	VulnPubDate         ProbablyString `json:"vulnPubDate,omitempty"`
-	ModifiedTime        ProbablyString `json:"modifiedTime,omitempty"`
-	CheckType           string         `json:"checkType,omitempty"`
+	Family              Family   `json:"family,omitempty"`
+	Type                string   `json:"type,omitempty"`
+	Copyright           string   `json:"copyright,omitempty"`
+	Version             string   `json:"version,omitempty"`
+	SourceFile          string   `json:"sourceFile,omitempty"`
+	Synopsis            string   `json:"synopsis,omitempty"`
+	Solution            string   `json:"solution,omitempty"`
+	RiskFactor          string   `json:"riskFactor,omitempty"`
+	STIGSeverity        string   `json:"stigSeverity,omitempty"`
+	Xrefs               string   `json:"xrefs,omitempty"`
+	CPE                 string   `json:"cpe,omitempty"`
+	SeeAlso             string   `json:"seeAlso,omitempty"`
+	Dependencies        string   `json:"dependencies,omitempty"`
+	RequiredPorts       string   `json:"requiredPorts,omitempty"`
+	RequiredUDPPorts    string   `json:"requiredUDPPorts,omitempty"`
+	ExploitAvailable    FakeBool `json:"exploitAvailable,omitempty"`
+	ExploitEase         string   `json:"exploitEase,omitempty"`
+	ExploitFrameworks   string   `json:"exploitFrameworks,omitempty"`
+	BaseScore           string   `json:"baseScore,omitempty"`
+	TemporalScore       string   `json:"temporalScore,omitempty"`
+	CVSSVector          string   `json:"cvssVector,omitempty"`
+	CVSSV3BaseScore     string   `json:"cvssV3BaseScore,omitempty"`
+	CVSSV3TemporalScore string   `json:"cvssV3TemporalScore,omitempty"`
+	CVSSV3Vector        string   `json:"cvssV3Vector,omitempty"`
+	VPRScore            string   `json:"vprScore,omitempty"`
+	// VPRContext is a JSON encoded list of the factors behind VPRScore; see VPRContextItems.
+	VPRContext    string         `json:"vprContext,omitempty"`
+	PluginPubDate ProbablyString `json:"pluginPubDate,omitempty"`
+	PluginModDate ProbablyString `json:"pluginModDate,omitempty"`
+	PatchPubDate  ProbablyString `json:"patchPubDate,omitempty"`
+	PatchModDate  ProbablyString `json:"patchModDate,omitempty"`
+	VulnPubDate   ProbablyString `json:"vulnPubDate,omitempty"`
+	ModifiedTime  ProbablyString `json:"modifiedTime,omitempty"`
+	CheckType     string         `json:"checkType,omitempty"`
+	// Preferences are the plugin's configurable settings, on SC releases that report them.
+	Preferences []PluginPreference `json:"preferences,omitempty"`
+}
+
+// PluginPreference is a single configurable setting of a plugin, as set through scan policy preferences.
+type PluginPreference struct {
+	Name string `json:"name,omitempty"`
+	// Type is the kind of input, such as 'entry', 'password', 'checkbox', 'radio' or 'file'.
+	Type string `json:"type,omitempty"`
+	// Default and Values are returned as strings or numbers depending on the setting.
+	Default ProbablyString `json:"default,omitempty"`
+	// Values lists the choices of radio settings.
+	Values []ProbablyString `json:"values,omitempty"`
+}
+
+// PluginXref is a single cross reference of a plugin, e.g. {"CVE", "CVE-2019-0708"} or {"IAVA", "2019-A-0155"}.
+type PluginXref struct {
+	Type string
+	ID   string
+}
+
+// PluginVPRContextItem is a single factor contributing to a plugin's VPR score.
+type PluginVPRContextItem struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Value is a string, number or boolean depending on Type.
+	Value interface{} `json:"value"`
+}
+
+// PluginDates is a typed view of the date fields of a Plugin; dates SC does not know are the zero time.
+type PluginDates struct {
+	PluginPubDate time.Time
+	PluginModDate time.Time
+	PatchPubDate  time.Time
+	PatchModDate  time.Time
+	VulnPubDate   time.Time
+	ModifiedTime  time.Time
+}
+
+// ParsePluginXrefs parses SC's comma separated `TYPE:ID` cross reference list.
+func ParsePluginXrefs(xrefs string) []PluginXref {
+	var parsed []PluginXref
+	for _, x := range splitPluginList(xrefs, ",") {
+		xrefType, id, found := strings.Cut(x, ":")
+		if !found {
+			continue
+		}
+		parsed = append(parsed, PluginXref{Type: strings.TrimSpace(xrefType), ID: strings.TrimSpace(id)})
+	}
+	return parsed
+}
+
+// splitPluginList splits s on any of the separator characters or newlines, dropping empty entries.
+func splitPluginList(s, separators string) []string {
+	var items []string
+	for _, item := range strings.FieldsFunc(s, func(r rune) bool { return r == '\n' || r == '\r' || strings.ContainsRune(separators, r) }) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// XrefList returns the plugin's parsed cross references.
+func (p Plugin) XrefList() []PluginXref {
+	return ParsePluginXrefs(p.Xrefs)
+}
+
+func (p Plugin) xrefsOfType(xrefType string) []string {
+	var ids []string
+	for _, x := range p.XrefList() {
+		if strings.EqualFold(x.Type, xrefType) {
+			ids = append(ids, x.ID)
+		}
+	}
+	return ids
+}
+
+// CVEs returns the CVE IDs the plugin references, e.g. "CVE-2019-0708".
+func (p Plugin) CVEs() []string {
+	return p.xrefsOfType("CVE")
+}
+
+// BIDs returns the Bugtraq IDs the plugin references.
+func (p Plugin) BIDs() []string {
+	return p.xrefsOfType("BID")
+}
+
+// CPEs returns the plugin's CPE list.
+func (p Plugin) CPEs() []string {
+	return splitPluginList(p.CPE, " ,")
+}
+
+// SeeAlsoURLs returns the plugin's reference URLs.
+func (p Plugin) SeeAlsoURLs() []string {
+	return splitPluginList(p.SeeAlso, " ")
+}
+
+// DependencyList returns the script names of the plugins this plugin depends on.
+func (p Plugin) DependencyList() []string {
+	return splitPluginList(p.Dependencies, " ,")
+}
+
+// VPRContextItems parses VPRContext.
+func (p Plugin) VPRContextItems() ([]PluginVPRContextItem, error) {
+	if p.VPRContext == "" {
+		return nil, nil
+	}
+
+	var items []PluginVPRContextItem
+	if err := json.Unmarshal([]byte(p.VPRContext), &items); err != nil {
+		return nil, fmt.Errorf("failed to parse vpr context of plugin %s: %w", p.ID, err)
+	}
+	return items, nil
+}
+
+// Dates parses the stringy date fields into a PluginDates.
+func (p Plugin) Dates() (*PluginDates, error) {
+	parser := typedFieldParser{}
+
+	dates := &PluginDates{
+		PluginPubDate: parser.time("pluginPubDate", p.PluginPubDate),
+		PluginModDate: parser.time("pluginModDate", p.PluginModDate),
+		PatchPubDate:  parser.time("patchPubDate", p.PatchPubDate),
+		PatchModDate:  parser.time("patchModDate", p.PatchModDate),
+		VulnPubDate:   parser.time("vulnPubDate", p.VulnPubDate),
+		ModifiedTime:  parser.time("modifiedTime", p.ModifiedTime),
+	}
+	if parser.err != nil {
+		return nil, fmt.Errorf("failed to parse plugin %s: %w", p.ID, parser.err)
+	}
+
+	return dates, nil
 }
 
 type Family struct {
@@ -53,3 +247,127 @@ func (c *Client) GetPluginsByName(name string) ([]*Plugin, error) {
 
 	return plugins, nil
 }
+
+// PluginSearch holds the query parameters accepted when listing https://docs.tenable.com/tenablesc/api/Plugin.htm
+type PluginSearch struct {
+	FilterField string
+	// Op may be 'eq', 'like', 'gt', 'gte', 'lt' or 'lte'; date filters compare epoch seconds.
+	Op    string
+	Value string
+	// Type restricts results to active, passive or LCE plugins; SC defaults to all.
+	Type string
+	// Since restricts results to plugins modified after the given time.
+	Since         time.Time
+	SortField     string
+	SortDirection string
+	// StartOffset and EndOffset page through results; an EndOffset of zero leaves paging to SC.
+	StartOffset int
+	EndOffset   int
+	// Fields selects the plugin fields returned; by default every field of Plugin is requested.
+	Fields []string
+}
+
+// PluginsModifiedSince builds a search for every plugin modified after t, such as by a feed update.
+func PluginsModifiedSince(t time.Time) PluginSearch {
+	return PluginSearch{
+		FilterField:   PluginFilterModifiedTime,
+		Op:            "gte",
+		Value:         strconv.FormatInt(t.Unix(), 10),
+		SortField:     "modifiedTime",
+		SortDirection: "ASC",
+	}
+}
+
+func (s PluginSearch) values() url.Values {
+	query := url.Values{}
+
+	if s.FilterField != "" {
+		query.Add("filterField", s.FilterField)
+		query.Add("op", s.Op)
+		query.Add("value", s.Value)
+	}
+	if s.Type != "" {
+		query.Add("type", s.Type)
+	}
+	if !s.Since.IsZero() {
+		query.Add("since", strconv.FormatInt(s.Since.Unix(), 10))
+	}
+	if s.SortField != "" {
+		query.Add("sortField", s.SortField)
+	}
+	if s.SortDirection != "" {
+		query.Add("sortDirection", s.SortDirection)
+	}
+	if s.EndOffset > 0 {
+		query.Add("startOffset", strconv.Itoa(s.StartOffset))
+		query.Add("endOffset", strconv.Itoa(s.EndOffset))
+	}
+
+	return query
+}
+
+func (c *Client) searchPlugins(endpoint string, s PluginSearch) ([]*Plugin, error) {
+	var plugins []*Plugin
+
+	fields := s.Fields
+	if len(fields) == 0 {
+		fields = getFieldsForStruct(plugins)
+	}
+
+	if _, err := c.getResourceWithFields(fmt.Sprintf("%s?%s", endpoint, s.values().Encode()), fields, &plugins); err != nil {
+		return nil, err
+	}
+
+	return plugins, nil
+}
+
+// SearchPlugins returns a single page of plugins matching s.
+func (c *Client) SearchPlugins(s PluginSearch) ([]*Plugin, error) {
+	plugins, err := c.searchPlugins(pluginEndpoint, s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search plugins with %s %s '%s': %w", s.FilterField, s.Op, s.Value, err)
+	}
+
+	return plugins, nil
+}
+
+// SearchAllPlugins pages through every plugin matching s, pageSize plugins per request, ignoring s's offsets.
+//
+//	Plugins returned more than once are only included the first time. Paging stops at the first short page,
+//	or at the first page containing no new plugins, which guards against SC ignoring the offsets.
+func (c *Client) SearchAllPlugins(s PluginSearch, pageSize int) ([]*Plugin, error) {
+	return c.searchAllPlugins(pluginEndpoint, s, pageSize)
+}
+
+func (c *Client) searchAllPlugins(endpoint string, s PluginSearch, pageSize int) ([]*Plugin, error) {
+	if pageSize <= 0 {
+		pageSize = defaultPluginPageSize
+	}
+
+	var all []*Plugin
+	seen := make(map[ProbablyString]bool)
+
+	for pages, offset := 0, 0; pages < maxPluginSearchPages; pages, offset = pages+1, offset+pageSize {
+		s.StartOffset, s.EndOffset = offset, offset+pageSize
+
+		page, err := c.searchPlugins(endpoint, s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search plugins from offset %d: %w", offset, err)
+		}
+
+		added := 0
+		for _, p := range page {
+			if !seen[p.ID] {
+				seen[p.ID] = true
+				all = append(all, p)
+				added++
+			}
+		}
+
+		if len(page) < pageSize || added == 0 {
+			return all, nil
+		}
+	}
+
+	return nil, fmt.Errorf("plugin search did not finish within %d pages of %d", maxPluginSearchPages, pageSize)
+}
@@ -0,0 +1,107 @@
+package tenablesc
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginSearchValues(t *testing.T) {
+	s := PluginsModifiedSince(time.Unix(1700000000, 0))
+	s.Type = PluginTypeActive
+	s.StartOffset, s.EndOffset = 50, 100
+
+	assert.Equal(t,
+		"endOffset=100&filterField=modifiedTime&op=gte&sortDirection=ASC&sortField=modifiedTime&startOffset=50&type=active&value=1700000000",
+		s.values().Encode())
+
+	assert.Equal(t, "", PluginSearch{StartOffset: 10}.values().Encode())
+}
+
+func TestPluginParsedFields(t *testing.T) {
+	p := Plugin{
+		Xrefs:         "CVE:CVE-2019-0708, BID:108273, IAVA:2019-A-0155, CVE:CVE-2019-0709",
+		CPE:           "cpe:/o:microsoft:windows\ncpe:/o:microsoft:windows_xp",
+		SeeAlso:       "https://example.com/a\nhttps://example.com/b",
+		Dependencies:  "smb_hotfixes.nasl, os_fingerprint.nasl",
+		VPRContext:    `[{"id":"exploit_code_maturity","name":"Exploit Code Maturity","type":"string","value":"HIGH"}]`,
+		PluginPubDate: "1558051200",
+		PatchPubDate:  "-1",
+	}
+
+	assert.Equal(t, PluginXref{Type: "BID", ID: "108273"}, p.XrefList()[1])
+	assert.Equal(t, []string{"CVE-2019-0708", "CVE-2019-0709"}, p.CVEs())
+	assert.Equal(t, []string{"108273"}, p.BIDs())
+	assert.Equal(t, []string{"cpe:/o:microsoft:windows", "cpe:/o:microsoft:windows_xp"}, p.CPEs())
+	assert.Equal(t, []string{"https://example.com/a", "https://example.com/b"}, p.SeeAlsoURLs())
+	assert.Equal(t, []string{"smb_hotfixes.nasl", "os_fingerprint.nasl"}, p.DependencyList())
+
+	items, err := p.VPRContextItems()
+	if assert.NoError(t, err) && assert.Len(t, items, 1) {
+		assert.Equal(t, "HIGH", items[0].Value)
+	}
+
+	dates, err := p.Dates()
+	if assert.NoError(t, err) {
+		assert.Equal(t, time.Unix(1558051200, 0), dates.PluginPubDate)
+		assert.True(t, dates.PatchPubDate.IsZero())
+	}
+}
+
+func TestPluginPreferences(t *testing.T) {
+	var p Plugin
+	err := json.Unmarshal([]byte(`{"id":"10180","preferences":[
+		{"name":"TCP ping destination port(s) :","type":"entry","default":"built-in"},
+		{"name":"Maximum retries :","type":"entry","default":6},
+		{"name":"Report dead hosts :","type":"radio","default":"no","values":["no","yes"]},
+		{"name":"Timeout :","type":"radio","values":[5,10]}]}`), &p)
+	if !assert.NoError(t, err) || !assert.Len(t, p.Preferences, 4) {
+		return
+	}
+	assert.Equal(t, PluginPreference{Name: "TCP ping destination port(s) :", Type: "entry", Default: "built-in"}, p.Preferences[0])
+	assert.Equal(t, ProbablyString("6"), p.Preferences[1].Default)
+	assert.Equal(t, []ProbablyString{"no", "yes"}, p.Preferences[2].Values)
+	assert.Equal(t, []ProbablyString{"5", "10"}, p.Preferences[3].Values)
+}
+
+func TestSearchAllPluginsStopsWhenOffsetsAreIgnored(t *testing.T) {
+	var requests []string
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		requests = append(requests, r.URL.Query().Get("startOffset"))
+		// Every page is the same full page, as from a server that ignores startOffset and endOffset.
+		return []*Plugin{{BaseInfo: BaseInfo{ID: "1"}}, {BaseInfo: BaseInfo{ID: "2"}}}
+	})
+
+	plugins, err := c.SearchAllPlugins(PluginSearch{}, 2)
+	if assert.NoError(t, err) && assert.Len(t, plugins, 2) {
+		assert.Equal(t, ProbablyString("1"), plugins[0].ID)
+		assert.Equal(t, ProbablyString("2"), plugins[1].ID)
+	}
+	assert.Equal(t, []string{"0", "2"}, requests)
+
+	requests = nil
+	plugins, err = c.GetAllPluginFamilyPlugins("10", PluginSearch{}, 2)
+	assert.NoError(t, err)
+	assert.Len(t, plugins, 2)
+	assert.Equal(t, []string{"0", "2"}, requests)
+}
+
+func TestSearchAllPluginsDropsRepeatedPlugins(t *testing.T) {
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		switch r.URL.Query().Get("startOffset") {
+		case "0":
+			return []*Plugin{{BaseInfo: BaseInfo{ID: "1"}}, {BaseInfo: BaseInfo{ID: "2"}}}
+		case "2":
+			return []*Plugin{{BaseInfo: BaseInfo{ID: "2"}}, {BaseInfo: BaseInfo{ID: "3"}}}
+		}
+		return []*Plugin{}
+	})
+
+	plugins, err := c.SearchAllPlugins(PluginSearch{}, 2)
+	if assert.NoError(t, err) && assert.Len(t, plugins, 3) {
+		assert.Equal(t, ProbablyString("3"), plugins[2].ID)
+	}
+}
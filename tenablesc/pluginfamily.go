@@ -0,0 +1,63 @@
+package tenablesc
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const pluginFamilyEndpoint = "/pluginFamily"
+
+// PluginFamily represents the response structure for https://docs.tenable.com/tenablesc/api/Plugin-Family.htm
+type PluginFamily struct {
+	Family
+	// Count is the number of plugins in the family.
+	Count ProbablyString `json:"count,omitempty"`
+}
+
+// GetAllPluginFamilies lists plugin families; pluginType may be empty, or one of the PluginType constants to restrict the listing.
+func (c *Client) GetAllPluginFamilies(pluginType string) ([]*PluginFamily, error) {
+	var families []*PluginFamily
+
+	endpoint := pluginFamilyEndpoint
+	if pluginType != "" {
+		query := url.Values{}
+		query.Add("type", pluginType)
+		endpoint = fmt.Sprintf("%s?%s", pluginFamilyEndpoint, query.Encode())
+	}
+
+	if _, err := c.getResource(endpoint, &families); err != nil {
+		return nil, fmt.Errorf("failed to get plugin families: %w", err)
+	}
+
+	return families, nil
+}
+
+func (c *Client) GetPluginFamily(id string) (*PluginFamily, error) {
+	family := &PluginFamily{}
+
+	if _, err := c.getResource(fmt.Sprintf("%s/%s", pluginFamilyEndpoint, id), family); err != nil {
+		return nil, fmt.Errorf("failed to get plugin family id %s: %w", id, err)
+	}
+
+	return family, nil
+}
+
+// SearchPluginFamilyPlugins returns a single page of the plugins in family {id} matching s.
+func (c *Client) SearchPluginFamilyPlugins(id string, s PluginSearch) ([]*Plugin, error) {
+	plugins, err := c.searchPlugins(fmt.Sprintf("%s/%s/plugins", pluginFamilyEndpoint, id), s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plugins of family id %s: %w", id, err)
+	}
+
+	return plugins, nil
+}
+
+// GetAllPluginFamilyPlugins pages through every plugin in family {id} matching s, pageSize plugins per request.
+func (c *Client) GetAllPluginFamilyPlugins(id string, s PluginSearch, pageSize int) ([]*Plugin, error) {
+	plugins, err := c.searchAllPlugins(fmt.Sprintf("%s/%s/plugins", pluginFamilyEndpoint, id), s, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plugins of family id %s: %w", id, err)
+	}
+
+	return plugins, nil
+}
@@ -4,15 +4,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 )
 
 const recastRiskRuleEndpoint = "/recastRiskRule"
 
 const (
-	RecastSeverityInfo   = "0"
-	RecastSeverityLow    = "1"
-	RecastSeverityMedium = "2"
-	RecastSeverityHigh   = "3"
+	RecastSeverityInfo     = SeverityInfo
+	RecastSeverityLow      = SeverityLow
+	RecastSeverityMedium   = SeverityMedium
+	RecastSeverityHigh     = SeverityHigh
+	RecastSeverityCritical = SeverityCritical
 )
 
 // RecastRiskRuleBaseFields are the fields renderable directly both to and from the API.
@@ -31,7 +33,7 @@ type RecastRiskRuleBaseFields struct {
 	Protocol     string              `json:"protocol,omitempty"`
 	Order        string              `json:"order,omitempty"`
 	Comments     string              `json:"comments,omitempty"`
-	Expires      string              `json:"expires,omitempty"`
+	Expires      *Expiry             `json:"expires,omitempty"`
 	Status       string              `json:"status,omitempty"`
 	CreatedTime  UnixEpochStringTime `json:"createdTime,omitempty"`
 	ModifiedTime UnixEpochStringTime `json:"modifiedTime,omitempty"`
@@ -50,7 +52,7 @@ type recastRiskRuleInternal struct {
 // RecastRiskRule represents the Risk Rule structure in https://docs.tenable.com/tenablesc/api/Recast-Risk-Rule.htm
 type RecastRiskRule struct {
 	RecastRiskRuleBaseFields
-	NewSeverity string `json:"newSeverity,omitempty"`
+	NewSeverity Severity `json:"newSeverity,omitempty"`
 	Repository  BaseInfo
 	HostValue   string
 }
@@ -81,7 +83,7 @@ func (a RecastRiskRule) toInternal() (*recastRiskRuleInternal, error) {
 		return nil, fmt.Errorf("HostType %s not supported in client", a.HostType)
 	}
 
-	newSeverityBytes, err := json.Marshal(&BaseInfo{ID: ProbablyString(a.NewSeverity)})
+	newSeverityBytes, err := json.Marshal(a.NewSeverity.BaseInfo())
 	if err != nil {
 		return nil, fmt.Errorf("could not parse newSeverity as string")
 	}
@@ -107,17 +109,35 @@ func (a recastRiskRuleInternal) toExternal() (*RecastRiskRule, error) {
 		}
 		rule.HostValue = string(assetInfo.ID)
 	case "ip", "uuid":
-		rule.HostValue = string(a.HostValue)
+		// Undo the json string encoding applied in toInternal, so values round trip.
+		if err := json.Unmarshal(a.HostValue, &rule.HostValue); err != nil {
+			return nil, fmt.Errorf("could not parse %s hostvalue %s as string", a.HostType, string(a.HostValue))
+		}
 	default:
 		return nil, fmt.Errorf("HostType %s not supported in client", a.HostType)
 	}
 
+	// newSeverity is sent as an id reference, but may come back either bare or as a reference.
 	var newSeverityString string
-	if err := json.Unmarshal(a.NewSeverity, &newSeverityString); err != nil {
-		return nil, fmt.Errorf("unable to unmarshal NewSeverity '%s' as string", a.NewSeverity)
+	if err := json.Unmarshal(a.NewSeverity, &newSeverityString); err == nil {
+		newSeverity, err := ParseSeverity(newSeverityString)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse NewSeverity '%s': %w", a.NewSeverity, err)
+		}
+		rule.NewSeverity = newSeverity
+		return rule, nil
 	}
 
-	rule.NewSeverity = newSeverityString
+	var newSeverityInfo BaseInfo
+	if err := json.Unmarshal(a.NewSeverity, &newSeverityInfo); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal NewSeverity '%s' as string or id struct", a.NewSeverity)
+	}
+
+	newSeverity, err := SeverityFromBaseInfo(newSeverityInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse NewSeverity '%s': %w", a.NewSeverity, err)
+	}
+	rule.NewSeverity = newSeverity
 
 	return rule, nil
 }
@@ -185,3 +205,85 @@ func (c *Client) DeleteRecastRiskRule(id string) error {
 
 	return nil
 }
+
+// UpdateRecastRiskRule replaces the fields of an existing recast risk rule, identified by its ID.
+func (c *Client) UpdateRecastRiskRule(a *RecastRiskRule) (*RecastRiskRule, error) {
+	resp := &recastRiskRuleInternal{}
+
+	aInt, err := a.toInternal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rule to internal format: %w", err)
+	}
+
+	if _, err := c.patchResourceWithID(recastRiskRuleEndpoint, aInt, resp); err != nil {
+		return nil, fmt.Errorf("failed to update recast risk rule: %w", err)
+	}
+
+	return resp.toExternal()
+}
+
+// ApplyRecastRiskRules pushes recast risk rules into repository {repositoryID} immediately
+//
+//	rather than waiting for the next import; an empty repositoryID applies rules to all repositories.
+func (c *Client) ApplyRecastRiskRules(repositoryID string) error {
+	if _, err := c.postResource(fmt.Sprintf("%s/apply", recastRiskRuleEndpoint), newRiskRuleApplyRequest(repositoryID), nil); err != nil {
+		return fmt.Errorf("failed to apply recast risk rules: %w", err)
+	}
+
+	return nil
+}
+
+// RecastRiskRuleBulkResult is the outcome of creating a rule for a single target in CreateRecastRiskRules.
+type RecastRiskRuleBulkResult struct {
+	Target RiskRuleTarget
+	Rule   *RecastRiskRule
+	Err    error
+}
+
+// CreateRecastRiskRules creates one rule per target, copying all other fields from template,
+//
+//	with at most concurrency requests in flight. Results are returned in target order;
+//	failures are reported per target rather than stopping the batch. An error is returned without
+//	creating anything if the template itself is unusable.
+func (c *Client) CreateRecastRiskRules(template *RecastRiskRule, targets []RiskRuleTarget, concurrency int) ([]RecastRiskRuleBulkResult, error) {
+	if template == nil || template.Repository.ID == "" {
+		return nil, errors.New("recast risk rule template has no repository")
+	}
+
+	results := make([]RecastRiskRuleBulkResult, len(targets))
+
+	forEachConcurrently(len(targets), concurrency, func(i int) {
+		target := targets[i]
+		rule := *template
+		rule.ID = ""
+		rule.Plugin = BaseInfo{ID: ProbablyString(target.PluginID)}
+		rule.HostType = target.HostType
+		rule.HostValue = target.HostValue
+
+		created, err := c.CreateRecastRiskRule(&rule)
+		results[i] = RecastRiskRuleBulkResult{Target: target, Rule: created, Err: err}
+	})
+
+	return results, nil
+}
+
+// GetRecastRiskRulesExpiringWithin returns the recast risk rules that expire between now and now+window,
+//
+//	e.g. to notify owners before exceptions lapse. Rules that never expire are not included.
+func (c *Client) GetRecastRiskRulesExpiringWithin(window time.Duration) ([]*RecastRiskRule, error) {
+	rules, err := c.GetAllRecastRiskRules()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var expiring []*RecastRiskRule
+
+	for _, r := range rules {
+		if expiresWithin(r.Expires, now, window) {
+			expiring = append(expiring, r)
+		}
+	}
+
+	return expiring, nil
+}
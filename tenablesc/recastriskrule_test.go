@@ -0,0 +1,39 @@
+package tenablesc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecastRiskRuleNewSeverityConversion(t *testing.T) {
+	for _, newSeverity := range []string{`{"id":"1","name":"Low"}`, `{"name":"Low"}`, `"1"`, `"low"`} {
+		internal := recastRiskRuleInternal{
+			RecastRiskRuleBaseFields: RecastRiskRuleBaseFields{HostType: "all"},
+			NewSeverity:              json.RawMessage(newSeverity),
+		}
+		rule, err := internal.toExternal()
+		if assert.NoError(t, err, newSeverity) {
+			assert.Equal(t, SeverityLow, rule.NewSeverity, newSeverity)
+		}
+	}
+
+	for _, newSeverity := range []string{`{"id":"7"}`, `"7"`, `""`, `1`, `[]`} {
+		internal := recastRiskRuleInternal{
+			RecastRiskRuleBaseFields: RecastRiskRuleBaseFields{HostType: "all"},
+			NewSeverity:              json.RawMessage(newSeverity),
+		}
+		_, err := internal.toExternal()
+		assert.Error(t, err, newSeverity)
+	}
+
+	internal, err := RecastRiskRule{
+		RecastRiskRuleBaseFields: RecastRiskRuleBaseFields{HostType: "all"},
+		NewSeverity:              SeverityCritical,
+		Repository:               BaseInfo{ID: "5"},
+	}.toInternal()
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"id":"4"}`, string(internal.NewSeverity))
+	}
+}
@@ -1,18 +1,35 @@
 package tenablesc
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
 )
 
 const reportEndpoint = "/report"
 
+// ReportStatus is the state of a report run as reported by SC.
+type ReportStatus string
+
+const (
+	ReportStatusQueued    ReportStatus = "Queued"
+	ReportStatusRunning   ReportStatus = "Running"
+	ReportStatusCompleted ReportStatus = "Completed"
+	ReportStatusError     ReportStatus = "Error"
+)
+
 // Report represents request/response structure from https://docs.tenable.com/tenablesc/api/Report.htm
 type Report struct {
 	BaseInfo
 	ReportDefinitionID string              `json:"reportDefinitionID"`
 	JobID              string              `json:"jobID"`
 	Type               string              `json:"type"`
-	Status             string              `json:"status"`
+	Status             ReportStatus        `json:"status"`
 	Running            string              `json:"running"`
 	ErrorDetails       string              `json:"errorDetails"`
 	TotalSteps         string              `json:"totalSteps"`
@@ -26,6 +43,42 @@ type Report struct {
 	OwnerGroup         BaseInfo            `json:"ownerGroup"`
 }
 
+// ReportProgress is a typed view of the status, timing and step fields of a Report.
+type ReportProgress struct {
+	Status          ReportStatus
+	Running         bool
+	StartTime       time.Time
+	FinishTime      time.Time
+	TotalSteps      int
+	CompletedSteps  int
+	PercentComplete float64
+}
+
+// Progress parses the stringy status, timing and step fields into a ReportProgress.
+func (r Report) Progress() (*ReportProgress, error) {
+	p := typedFieldParser{}
+
+	progress := &ReportProgress{
+		Status:         r.Status,
+		Running:        FakeBool(r.Running).AsBool(),
+		StartTime:      p.time("startTime", ProbablyString(r.StartTime)),
+		FinishTime:     p.time("finishTime", ProbablyString(r.FinishTime)),
+		TotalSteps:     p.int("totalSteps", ProbablyString(r.TotalSteps)),
+		CompletedSteps: p.int("completedSteps", ProbablyString(r.CompletedSteps)),
+	}
+	if p.err != nil {
+		return nil, fmt.Errorf("failed to parse report %s: %w", r.ID, p.err)
+	}
+
+	if r.Status == ReportStatusCompleted {
+		progress.PercentComplete = 100
+	} else {
+		progress.PercentComplete = percent(progress.CompletedSteps, progress.TotalSteps)
+	}
+
+	return progress, nil
+}
+
 type allReportsResponse struct {
 	Manageable []*Report `json:"manageable" tenable:"recurse"`
 	Usable     []*Report `json:"usable" tenable:"recurse"`
@@ -68,3 +121,149 @@ func (c *Client) GetReport(id string) (*Report, error) {
 
 	return resp, nil
 }
+
+type reportUsersRequest struct {
+	Users []BaseInfo `json:"users,omitempty"`
+	Email string     `json:"email,omitempty"`
+}
+
+func toUserRefs(userIDs []string) []BaseInfo {
+	var users []BaseInfo
+	for _, id := range userIDs {
+		users = append(users, BaseInfo{ID: ProbablyString(id)})
+	}
+	return users
+}
+
+// reportSniffLength is how much of a download is retained for content type detection.
+const reportSniffLength = 512
+
+// sniffingWriter passes writes through while retaining the start of the stream.
+type sniffingWriter struct {
+	w    io.Writer
+	head []byte
+}
+
+func (s *sniffingWriter) Write(p []byte) (int, error) {
+	if remaining := reportSniffLength - len(s.head); remaining > 0 {
+		s.head = append(s.head, p[:min(remaining, len(p))]...)
+	}
+	return s.w.Write(p)
+}
+
+// detectReportType maps a download's content type header, or failing that its leading bytes,
+//
+//	to one of ReportTypePDF, ReportTypeCSV or ReportTypeRTF. An empty string is returned if nothing matches.
+func detectReportType(contentType string, head []byte) string {
+	mediaType := strings.ToLower(contentType)
+	switch {
+	case strings.Contains(mediaType, "pdf"):
+		return ReportTypePDF
+	case strings.Contains(mediaType, "rtf"):
+		return ReportTypeRTF
+	case strings.Contains(mediaType, "csv"):
+		return ReportTypeCSV
+	}
+
+	switch {
+	case bytes.HasPrefix(head, []byte("%PDF-")):
+		return ReportTypePDF
+	case bytes.HasPrefix(head, []byte(`{\rtf`)):
+		return ReportTypeRTF
+	case len(head) > 0 && strings.HasPrefix(http.DetectContentType(head), "text/plain"):
+		return ReportTypeCSV
+	}
+
+	return ""
+}
+
+// DownloadReport streams the generated output of report {id} to w and returns its detected type,
+//
+//	one of ReportTypePDF, ReportTypeCSV or ReportTypeRTF, or an empty string if it could not be determined.
+func (c *Client) DownloadReport(id string, w io.Writer) (string, error) {
+	sw := &sniffingWriter{w: w}
+
+	resp, err := c.streamResource(resty.MethodPost, fmt.Sprintf("%s/%s/download", reportEndpoint, id), nil, sw)
+	if err != nil {
+		return "", fmt.Errorf("failed to download report id %s: %w", id, err)
+	}
+
+	return detectReportType(resp.response.Header().Get("Content-Type"), sw.head), nil
+}
+
+// StopReport stops a queued or running report.
+func (c *Client) StopReport(id string) error {
+	if _, err := c.postResource(fmt.Sprintf("%s/%s/stop", reportEndpoint, id), nil, nil); err != nil {
+		return fmt.Errorf("failed to stop report id %s: %w", id, err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteReport(id string) error {
+	if _, err := c.deleteResource(fmt.Sprintf("%s/%s", reportEndpoint, id), nil, nil); err != nil {
+		return fmt.Errorf("unable to delete report with id %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// EmailReport sends the output of report {id} to the given SC users and/or email addresses.
+func (c *Client) EmailReport(id string, userIDs []string, emails []string) error {
+	req := &reportUsersRequest{
+		Users: toUserRefs(userIDs),
+		Email: strings.Join(emails, ","),
+	}
+
+	if _, err := c.postResource(fmt.Sprintf("%s/%s/email", reportEndpoint, id), req, nil); err != nil {
+		return fmt.Errorf("failed to email report id %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// CopyReport gives each of the given SC users their own copy of report {id}.
+func (c *Client) CopyReport(id string, userIDs []string) error {
+	if _, err := c.postResource(fmt.Sprintf("%s/%s/copy", reportEndpoint, id), &reportUsersRequest{Users: toUserRefs(userIDs)}, nil); err != nil {
+		return fmt.Errorf("failed to copy report id %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// WaitForReport polls report {id} every interval until it completes, otherwise stops running, or timeout elapses.
+//
+//	onProgress, if not nil, is called after each poll. A report ending in any status other than Completed,
+//	such as Error or after being stopped, is returned along with an error.
+func (c *Client) WaitForReport(id string, interval, timeout time.Duration, onProgress func(*ReportProgress)) (*Report, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		r, err := c.GetReport(id)
+		if err != nil {
+			return nil, err
+		}
+
+		progress, err := r.Progress()
+		if err != nil {
+			return r, err
+		}
+		if onProgress != nil {
+			onProgress(progress)
+		}
+
+		switch {
+		case r.Status == ReportStatusCompleted:
+			return r, nil
+		case r.Status == ReportStatusError:
+			return r, fmt.Errorf("report id %s failed: %s", id, r.ErrorDetails)
+		case r.Status != ReportStatusQueued && r.Status != ReportStatusRunning && !progress.Running:
+			return r, fmt.Errorf("report id %s ended with status %s", id, r.Status)
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return r, fmt.Errorf("timed out waiting for report id %s after %s, status %s", id, timeout, r.Status)
+		}
+		time.Sleep(interval)
+	}
+}
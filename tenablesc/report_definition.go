@@ -6,11 +6,101 @@ import (
 
 const reportDefinitionEndpoint = "/reportDefinition"
 
+// Values for ReportDefinition.Type.
+const (
+	ReportTypePDF = "pdf"
+	ReportTypeCSV = "csv"
+	ReportTypeRTF = "rtf"
+)
+
 // ReportDefinitionBase represents the base request/response structure from https://docs.tenable.com/tenablesc/api/Report-Definition.htm
 type ReportDefinitionBase struct {
 	BaseInfo
 }
 
+// ReportDefinition represents the full request/response structure from https://docs.tenable.com/tenablesc/api/Report-Definition.htm
+type ReportDefinition struct {
+	BaseInfo
+	Type       string                   `json:"type,omitempty"`
+	Definition *ReportDefinitionContent `json:"definition,omitempty"`
+	// Schedule uses the same structure as scan schedules; type 'template' definitions only run when launched.
+	Schedule        *ScanSchedule `json:"schedule,omitempty"`
+	EmailUsers      []UserInfo    `json:"emailUsers,omitempty"`
+	EmailTargets    string        `json:"emailTargets,omitempty"`
+	EmailTargetType string        `json:"emailTargetType,omitempty"`
+	ShareUsers      []UserInfo    `json:"shareUsers,omitempty"`
+	PubSites        []BaseInfo    `json:"pubSites,omitempty"`
+	// EncryptionPassword protects generated PDFs; SC never returns it.
+	EncryptionPassword string              `json:"encryptionPassword,omitempty"`
+	StyleFamily        *BaseInfo           `json:"styleFamily,omitempty"`
+	Attributes         []BaseInfo          `json:"attributeSets,omitempty"`
+	Status             string              `json:"status,omitempty"`
+	Owner              *UserInfo           `json:"owner,omitempty"`
+	OwnerGroup         *BaseInfo           `json:"ownerGroup,omitempty"`
+	Creator            *UserInfo           `json:"creator,omitempty"`
+	CreatedTime        UnixEpochStringTime `json:"createdTime,omitempty"`
+	ModifiedTime       UnixEpochStringTime `json:"modifiedTime,omitempty"`
+}
+
+// ReportDefinitionContent is the layout of a report.
+//
+//	PDF and RTF reports are built from Components (chapters containing tables, charts and text),
+//	while CSV reports are a single DataSource query rendered with Columns.
+type ReportDefinitionContent struct {
+	Components []ReportComponent `json:"components,omitempty"`
+	DataSource *ReportDataSource `json:"dataSource,omitempty"`
+	Columns    []BaseInfo        `json:"columns,omitempty"`
+	// DataPoints limits the number of rows in CSV reports.
+	DataPoints string `json:"dataPoints,omitempty"`
+}
+
+// ReportComponent is a chapter, group or element of a PDF or RTF report.
+type ReportComponent struct {
+	// Type may be e.g. 'chapter', 'group', 'table', 'barChart', 'pieChart', 'lineChart', 'matrix' or 'paragraph'.
+	Type       string            `json:"type,omitempty"`
+	Name       string            `json:"name,omitempty"`
+	Components []ReportComponent `json:"components,omitempty"`
+	// Definition holds the type-specific settings of the component, such as a table's dataSource and columns.
+	// These vary widely by type and are best discovered by inspecting an existing definition.
+	Definition map[string]interface{} `json:"definition,omitempty"`
+}
+
+// ReportDataSource is the query feeding a report or report component.
+//
+//	Either QueryID, referencing a saved query, or Query should be set.
+type ReportDataSource struct {
+	QueryID         ProbablyString `json:"queryID,omitempty"`
+	Query           *AnalysisQuery `json:"query,omitempty"`
+	QueryType       string         `json:"queryType,omitempty"`
+	QuerySourceType string         `json:"querySourceType,omitempty"`
+	SortColumn      string         `json:"sortColumn,omitempty"`
+	SortDirection   string         `json:"sortDirection,omitempty"`
+}
+
+type reportDefinitionCopyRequest struct {
+	Name       string    `json:"name,omitempty"`
+	TargetUser *UserInfo `json:"targetUser,omitempty"`
+}
+
+type reportDefinitionLaunchResponse struct {
+	ReportResult BaseInfo `json:"reportResult"`
+}
+
+// withoutReadOnlyFields returns a copy of the definition with server-managed fields cleared,
+//
+//	so a fetched definition can be submitted as a create request.
+func (r ReportDefinition) withoutReadOnlyFields() *ReportDefinition {
+	r.ID = ""
+	r.Status = ""
+	r.Creator = nil
+	r.CreatedTime = ""
+	r.ModifiedTime = ""
+	if r.Schedule != nil {
+		r.Schedule = r.Schedule.withoutReadOnlyFields()
+	}
+	return &r
+}
+
 type allReportDefinitionsResponse struct {
 	Manageable []*ReportDefinitionBase `json:"manageable" tenable:"recurse"`
 	Usable     []*ReportDefinitionBase `json:"usable" tenable:"recurse"`
@@ -42,3 +132,153 @@ func (c *Client) GetAllReportDefinitions() ([]*ReportDefinitionBase, error) {
 
 	return allReportDefinitions.allReportDefinitionsToExternal(), nil
 }
+
+func (c *Client) GetReportDefinition(id string) (*ReportDefinition, error) {
+	resp := &ReportDefinition{}
+
+	if _, err := c.getResource(fmt.Sprintf("%s/%s", reportDefinitionEndpoint, id), resp); err != nil {
+		return nil, fmt.Errorf("failed to get report definition id %s: %w", id, err)
+	}
+
+	return resp, nil
+}
+
+// CreateReportDefinition creates a new report definition; server-managed fields such as ID are not sent,
+//
+//	so a definition fetched from the API may be passed directly.
+func (c *Client) CreateReportDefinition(r *ReportDefinition) (*ReportDefinition, error) {
+	resp := &ReportDefinition{}
+
+	if _, err := c.postResource(reportDefinitionEndpoint, r.withoutReadOnlyFields(), resp); err != nil {
+		return nil, fmt.Errorf("failed to create report definition: %w", err)
+	}
+
+	return resp, nil
+}
+
+// UpdateReportDefinition updates the report definition identified by r.ID; as with CreateReportDefinition,
+//
+//	server-managed fields are not sent, so a fetched and modified definition may be passed directly.
+func (c *Client) UpdateReportDefinition(r *ReportDefinition) (*ReportDefinition, error) {
+	resp := &ReportDefinition{}
+
+	update := r.withoutReadOnlyFields()
+	update.ID = r.ID
+
+	if _, err := c.patchResourceWithID(reportDefinitionEndpoint, update, resp); err != nil {
+		return nil, fmt.Errorf("failed to update report definition: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) DeleteReportDefinition(id string) error {
+	if _, err := c.deleteResource(fmt.Sprintf("%s/%s", reportDefinitionEndpoint, id), nil, nil); err != nil {
+		return fmt.Errorf("unable to delete report definition with id %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// CopyReportDefinition asks SC to copy report definition {id} under a new name.
+//
+//	targetUserID may be empty to keep the copy with the current user.
+func (c *Client) CopyReportDefinition(id, name, targetUserID string) (*ReportDefinition, error) {
+	req := &reportDefinitionCopyRequest{Name: name}
+	if targetUserID != "" {
+		req.TargetUser = &UserInfo{ID: ProbablyString(targetUserID)}
+	}
+
+	resp := &ReportDefinition{}
+
+	if _, err := c.postResource(fmt.Sprintf("%s/%s/copy", reportDefinitionEndpoint, id), req, resp); err != nil {
+		return nil, fmt.Errorf("failed to copy report definition id %s: %w", id, err)
+	}
+
+	return resp, nil
+}
+
+// LaunchReportDefinition starts a run of report definition {id} and returns the resulting Report,
+//
+//	which will generally still be queued or running.
+func (c *Client) LaunchReportDefinition(id string) (*Report, error) {
+	resp := &reportDefinitionLaunchResponse{}
+
+	if _, err := c.postResource(fmt.Sprintf("%s/%s/launch", reportDefinitionEndpoint, id), nil, resp); err != nil {
+		return nil, fmt.Errorf("failed to launch report definition id %s: %w", id, err)
+	}
+
+	return c.GetReport(string(resp.ReportResult.ID))
+}
+
+// NewCSVReportDefinition builds an on-demand CSV report definition that runs Analysis a on the SC report engine.
+//
+//	The query, source type, sort and columns of a carry over; offsets do not, as the report covers every match.
+//	If a.Query has an ID the saved query is referenced rather than copied. DataPoints is left to SC's default.
+func NewCSVReportDefinition(name string, a *Analysis) (*ReportDefinition, error) {
+	if len(a.Columns) == 0 {
+		return nil, fmt.Errorf("analysis for report %s has no columns, csv reports require at least one", name)
+	}
+
+	source := &ReportDataSource{
+		QueryType:       a.Type,
+		QuerySourceType: a.SourceType,
+		SortColumn:      a.SortField,
+		SortDirection:   a.SortDirection,
+	}
+	if source.QueryType == "" {
+		source.QueryType = a.Query.Type
+	}
+	if source.QuerySourceType == "" {
+		source.QuerySourceType = a.Query.SourceType
+	}
+
+	if a.Query.ID != "" {
+		source.QueryID = ProbablyString(a.Query.ID)
+	} else {
+		if a.Query.Tool == "" {
+			return nil, fmt.Errorf("analysis for report %s has an empty query tool", name)
+		}
+		query := a.Query
+		if query.Type == "" {
+			query.Type = source.QueryType
+		}
+		if query.SourceType == "" {
+			query.SourceType = source.QuerySourceType
+		}
+		source.Query = &query
+	}
+
+	return &ReportDefinition{
+		BaseInfo: BaseInfo{Name: name},
+		Type:     ReportTypeCSV,
+		Definition: &ReportDefinitionContent{
+			DataSource: source,
+			Columns:    a.Columns,
+		},
+		Schedule: &ScanSchedule{Type: ScanScheduleTypeTemplate},
+	}, nil
+}
+
+// LaunchAnalysisReport composes the NewCSVReportDefinition, CreateReportDefinition and LaunchReportDefinition
+//
+//	calls necessary to export the results of Analysis a server-side. The created definition is returned so it can be
+//	relaunched or deleted once the report has been downloaded.
+func (c *Client) LaunchAnalysisReport(name string, a *Analysis) (*ReportDefinition, *Report, error) {
+	def, err := NewCSVReportDefinition(name, a)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created, err := c.CreateReportDefinition(def)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report, err := c.LaunchReportDefinition(string(created.ID))
+	if err != nil {
+		return created, nil, err
+	}
+
+	return created, report, nil
+}
@@ -0,0 +1,107 @@
+package tenablesc
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCSVReportDefinition(t *testing.T) {
+	a := &Analysis{
+		Type:          "vuln",
+		SourceType:    "cumulative",
+		SortField:     "severity",
+		SortDirection: "desc",
+		Columns:       []BaseInfo{{Name: "pluginID"}, {Name: "ip"}},
+		StartOffset:   "0",
+		EndOffset:     "50",
+		Query: AnalysisQuery{
+			Tool:    "vulndetails",
+			Filters: []AnalysisFilter{MinimumSeverityFilter(SeverityHigh)},
+		},
+	}
+
+	def, err := NewCSVReportDefinition("high and critical", a)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, ReportTypeCSV, def.Type)
+	assert.Equal(t, ScanScheduleTypeTemplate, def.Schedule.Type)
+	assert.Equal(t, a.Columns, def.Definition.Columns)
+
+	source := def.Definition.DataSource
+	assert.Equal(t, "vuln", source.QueryType)
+	assert.Equal(t, "cumulative", source.QuerySourceType)
+	assert.Equal(t, "severity", source.SortColumn)
+	if assert.NotNil(t, source.Query) {
+		assert.Equal(t, "vulndetails", source.Query.Tool)
+		assert.Equal(t, "vuln", source.Query.Type)
+	}
+
+	a.Query = AnalysisQuery{ID: "42"}
+	def, err = NewCSVReportDefinition("saved", a)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ProbablyString("42"), def.Definition.DataSource.QueryID)
+		assert.Nil(t, def.Definition.DataSource.Query)
+	}
+
+	a.Columns = nil
+	_, err = NewCSVReportDefinition("no columns", a)
+	assert.Error(t, err)
+}
+
+func TestReportDefinitionWithoutReadOnlyFields(t *testing.T) {
+	r := ReportDefinition{
+		BaseInfo:     BaseInfo{ID: "1", Name: "report"},
+		Type:         ReportTypeCSV,
+		Schedule:     &ScanSchedule{ID: "2", Type: ScanScheduleTypeTemplate},
+		Status:       "0",
+		Owner:        &UserInfo{ID: "3"},
+		Creator:      &UserInfo{ID: "4"},
+		CreatedTime:  "1700000000",
+		ModifiedTime: "1700000001",
+	}
+
+	assert.Equal(t, &ReportDefinition{
+		BaseInfo: BaseInfo{Name: "report"},
+		Type:     ReportTypeCSV,
+		Schedule: &ScanSchedule{Type: ScanScheduleTypeTemplate},
+		Owner:    &UserInfo{ID: "3"},
+	}, r.withoutReadOnlyFields())
+}
+
+func TestUpdateReportDefinitionStripsReadOnlyFields(t *testing.T) {
+	var path string
+	var body map[string]interface{}
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		path = r.Method + " " + r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return err
+		}
+		return ReportDefinition{BaseInfo: BaseInfo{ID: "1"}}
+	})
+
+	_, err := c.UpdateReportDefinition(&ReportDefinition{
+		BaseInfo:     BaseInfo{ID: "1", Name: "renamed"},
+		Schedule:     &ScanSchedule{ID: "2", Type: ScanScheduleTypeTemplate, NextRun: 1700000000},
+		Status:       "0",
+		Owner:        &UserInfo{ID: "3"},
+		Creator:      &UserInfo{ID: "4"},
+		CreatedTime:  "1700000000",
+		ModifiedTime: "1700000001",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "PATCH "+reportDefinitionEndpoint+"/1", path)
+	assert.Equal(t, map[string]interface{}{
+		"id":       "1",
+		"name":     "renamed",
+		"schedule": map[string]interface{}{"type": ScanScheduleTypeTemplate},
+		"owner":    map[string]interface{}{"id": "3"},
+	}, body)
+}
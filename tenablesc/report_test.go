@@ -0,0 +1,92 @@
+package tenablesc
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectReportType(t *testing.T) {
+	assert.Equal(t, ReportTypePDF, detectReportType("application/pdf", nil))
+	assert.Equal(t, ReportTypeCSV, detectReportType("text/csv; charset=utf-8", nil))
+	assert.Equal(t, ReportTypeRTF, detectReportType("application/octet-stream", []byte(`{\rtf1\ansi`)))
+	assert.Equal(t, ReportTypePDF, detectReportType("application/octet-stream", []byte("%PDF-1.4\n")))
+	assert.Equal(t, ReportTypeCSV, detectReportType("", []byte("Plugin,Plugin Name\n19506,Nessus Scan Information\n")))
+	assert.Equal(t, "", detectReportType("application/octet-stream", []byte{0x1f, 0x8b, 0x08}))
+}
+
+func TestReportProgress(t *testing.T) {
+	progress, err := Report{
+		Status:         ReportStatusRunning,
+		Running:        "true",
+		StartTime:      "1700000000",
+		FinishTime:     "-1",
+		TotalSteps:     "8",
+		CompletedSteps: "2",
+	}.Progress()
+	if assert.NoError(t, err) {
+		assert.True(t, progress.Running)
+		assert.Equal(t, time.Unix(1700000000, 0), progress.StartTime)
+		assert.True(t, progress.FinishTime.IsZero())
+		assert.Equal(t, 25.0, progress.PercentComplete)
+	}
+
+	// Completed reports are complete even when SC leaves the step counts behind.
+	progress, err = Report{Status: ReportStatusCompleted, TotalSteps: "8", CompletedSteps: "7"}.Progress()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 100.0, progress.PercentComplete)
+	}
+
+	_, err = Report{TotalSteps: "many"}.Progress()
+	assert.Error(t, err)
+}
+
+func TestWaitForReport(t *testing.T) {
+	for _, tc := range []struct {
+		final   Report
+		wantErr string
+	}{
+		{Report{Status: ReportStatusCompleted, Running: "false"}, ""},
+		{Report{Status: ReportStatusError, Running: "false", ErrorDetails: "no data"}, "failed: no data"},
+		{Report{Status: "Stopped", Running: "false"}, "ended with status Stopped"},
+	} {
+		polls := 0
+		c := newStubClient(t, func(r *http.Request) interface{} {
+			polls++
+			switch polls {
+			case 1:
+				return Report{Status: ReportStatusQueued, Running: "false"}
+			case 2:
+				return Report{Status: ReportStatusRunning, Running: "true", TotalSteps: "4", CompletedSteps: "1"}
+			}
+			return tc.final
+		})
+
+		var seen []ReportStatus
+		r, err := c.WaitForReport("1", time.Millisecond, time.Minute, func(p *ReportProgress) {
+			seen = append(seen, p.Status)
+		})
+		if tc.wantErr == "" {
+			assert.NoError(t, err)
+		} else if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), tc.wantErr)
+		}
+		if assert.NotNil(t, r) {
+			assert.Equal(t, tc.final.Status, r.Status)
+		}
+		assert.Equal(t, []ReportStatus{ReportStatusQueued, ReportStatusRunning, tc.final.Status}, seen)
+	}
+}
+
+func TestWaitForReportTimeout(t *testing.T) {
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		return Report{Status: ReportStatusRunning, Running: "true"}
+	})
+
+	_, err := c.WaitForReport("1", time.Millisecond, 0, nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "timed out")
+	}
+}
@@ -2,19 +2,41 @@ package tenablesc
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
+	"io"
 	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/palantir/tenablesc-client/schedule"
 )
 
 const reposEndpoint = "/repository"
 
+// Values for RepoBaseFields.Type.
+const (
+	RepoTypeLocal   = "Local"
+	RepoTypeRemote  = "Remote"
+	RepoTypeOffline = "Offline"
+)
+
+// Values for RepoBaseFields.DataFormat.
+const (
+	RepoDataFormatIPv4   = "IPv4"
+	RepoDataFormatIPv6   = "IPv6"
+	RepoDataFormatMobile = "mobile"
+	RepoDataFormatAgent  = "agent"
+)
+
 // Repository represents the fields for https://docs.tenable.com/tenablesc/api/Repository.htm
-// Each repository type has a significantly different structure that is rendered as needed.
+// Each repository type has a significantly different structure that is rendered as needed;
+// only the embedded field sets relevant to the repository's Type and DataFormat are populated.
+// Agent repositories have only RepoFieldsCommon.
 type Repository struct {
 	RepoBaseFields
 	RepoFieldsCommon
 	RepoIPFields
+	RepoRemoteFields
+	RepoMobileFields
 }
 
 // RepoFieldsCommon includes the fields common to requests and responses in this endpoint for all repository types.
@@ -36,6 +58,20 @@ type RepoIPFields struct {
 	NessusSchedule         *NessusSchedule     `json:"nessusSchedule,omitempty"`
 }
 
+// RepoRemoteFields includes the fields only available in Remote repositories, which mirror a repository on another SC.
+type RepoRemoteFields struct {
+	RemoteID       string              `json:"remoteID,omitempty"`
+	RemoteIP       string              `json:"remoteIP,omitempty"`
+	RemoteSchedule *NessusSchedule     `json:"remoteSchedule,omitempty"`
+	LastSyncTime   UnixEpochStringTime `json:"lastSyncTime,omitempty"`
+}
+
+// RepoMobileFields includes the fields only available in mobile repositories.
+type RepoMobileFields struct {
+	MDM            *BaseInfo       `json:"mdm,omitempty"`
+	MobileSchedule *NessusSchedule `json:"scanSchedule,omitempty"`
+}
+
 // RepoBaseFields includes the Repository fields common to responses from this endpoint and others.
 type RepoBaseFields struct {
 	BaseInfo
@@ -54,9 +90,26 @@ type NessusSchedule struct {
 	RepeatRule string `json:"repeatRule,omitempty"`
 }
 
+// ToSchedule parses Start and RepeatRule; it is only meaningful for ical schedules.
+func (n NessusSchedule) ToSchedule() (*schedule.Schedule, error) {
+	if n.Type != ScanScheduleTypeICal {
+		return nil, fmt.Errorf("schedule type %s has no ical start and repeat rule", n.Type)
+	}
+	return schedule.Parse(n.Start, n.RepeatRule)
+}
+
+// Values for RepoOrganization.GroupAssign, controlling which of an organization's groups can see the repository.
+const (
+	GroupAssignAll        = "all"
+	GroupAssignPartial    = "partial"
+	GroupAssignFullAccess = "fullAccess"
+)
+
 type RepoOrganization struct {
 	ID          string `json:"id,omitempty"`
 	GroupAssign string `json:"groupAssign,omitempty"`
+	// Groups lists the organization groups given access when GroupAssign is GroupAssignPartial.
+	Groups []BaseInfo `json:"groups,omitempty"`
 }
 
 // input and output formats are different.  Handle the differences internally
@@ -66,26 +119,43 @@ type repoInternal struct {
 }
 
 func (r repoInternal) toExternal() (*Repository, error) {
-	if r.Type != "Local" {
-		return nil, fmt.Errorf("Repo type %s is not supported.  Only local is", r.Type)
+	switch r.Type {
+	case RepoTypeLocal, RepoTypeRemote, RepoTypeOffline:
+	default:
+		return nil, fmt.Errorf("repo type %s is not supported", r.Type)
 	}
 
 	repo := &Repository{
 		RepoBaseFields: r.RepoBaseFields,
 	}
 
-	if r.DataFormat == "mobile" {
-		return nil, errors.New("mobile repo data format is not supported")
+	if len(r.TypeFields) == 0 {
+		return repo, nil
 	}
 
 	if err := json.Unmarshal(r.TypeFields, &repo.RepoFieldsCommon); err != nil {
 		return nil, fmt.Errorf("faild to unmarshal typeFields: %w", err)
 	}
 
-	if strings.HasPrefix(r.DataFormat, "IP") {
+	switch {
+	case strings.HasPrefix(r.DataFormat, "IP"):
 		if err := json.Unmarshal(r.TypeFields, &repo.RepoIPFields); err != nil {
 			return nil, fmt.Errorf("faild to unmarshal typeFields: %w", err)
 		}
+	case r.DataFormat == RepoDataFormatMobile:
+		if err := json.Unmarshal(r.TypeFields, &repo.RepoMobileFields); err != nil {
+			return nil, fmt.Errorf("faild to unmarshal typeFields: %w", err)
+		}
+	case r.DataFormat == RepoDataFormatAgent:
+		// agent repositories only carry the common fields.
+	default:
+		return nil, fmt.Errorf("repo data format %s is not supported", r.DataFormat)
+	}
+
+	if r.Type == RepoTypeRemote {
+		if err := json.Unmarshal(r.TypeFields, &repo.RepoRemoteFields); err != nil {
+			return nil, fmt.Errorf("faild to unmarshal typeFields: %w", err)
+		}
 	}
 
 	return repo, nil
@@ -114,6 +184,32 @@ func (c *Client) GetAllRepositories() ([]*Repository, error) {
 	return repoSliceToExternal(r)
 }
 
+// GetAllRepositoriesTolerant is GetAllRepositories for servers that may have repositories the client cannot render.
+//
+//	Repositories that fail conversion are still returned with only their RepoBaseFields populated,
+//	and the conversion errors are returned alongside rather than failing the call.
+func (c *Client) GetAllRepositoriesTolerant() ([]*Repository, []error, error) {
+	var r []repoInternal
+
+	if _, err := c.getResource(reposEndpoint, &r); err != nil {
+		return nil, nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	repos := make([]*Repository, 0, len(r))
+	var convErrs []error
+
+	for _, ri := range r {
+		repo, err := ri.toExternal()
+		if err != nil {
+			convErrs = append(convErrs, fmt.Errorf("repository %s: %w", ri.ID, err))
+			repo = &Repository{RepoBaseFields: ri.RepoBaseFields}
+		}
+		repos = append(repos, repo)
+	}
+
+	return repos, convErrs, nil
+}
+
 func (c *Client) CreateRepository(r *Repository) (*Repository, error) {
 	resp := &repoInternal{}
 
@@ -151,3 +247,114 @@ func (c *Client) DeleteRepository(id string) error {
 	}
 	return nil
 }
+
+type repoImportRequest struct {
+	File string `json:"file"`
+}
+
+// ExportRepository streams the export archive of repository {id} to w,
+//
+//	for moving data to an offline repository on another SC.
+func (c *Client) ExportRepository(id string, w io.Writer) error {
+	if _, err := c.streamResource(resty.MethodGet, fmt.Sprintf("%s/%s/export", reposEndpoint, id), nil, w); err != nil {
+		return fmt.Errorf("failed to export repo %s: %w", id, err)
+	}
+	return nil
+}
+
+// ImportRepository imports a previously uploaded export archive into offline repository {id}.
+//
+//	filename is the server-side name returned by UploadFile.
+func (c *Client) ImportRepository(id, filename string) error {
+	if _, err := c.postResource(fmt.Sprintf("%s/%s/import", reposEndpoint, id), &repoImportRequest{File: filename}, nil); err != nil {
+		return fmt.Errorf("failed to import %s into repo %s: %w", filename, id, err)
+	}
+	return nil
+}
+
+// ImportRepositoryFromReader composes the UploadFileFromReader and ImportRepository calls necessary
+//
+//	to import an export archive into offline repository {id}; name is used as the uploaded file name.
+func (c *Client) ImportRepositoryFromReader(id string, reader io.Reader, name string) error {
+	file, err := c.UploadFileFromReader(reader, name, "")
+	if err != nil {
+		return fmt.Errorf("failed to upload file for repository import: %w", err)
+	}
+
+	return c.ImportRepository(id, file.Filename)
+}
+
+// repoOrganizationsPatch updates only the organization assignments of a repository,
+//
+//	leaving every other field as it is on the server.
+type repoOrganizationsPatch struct {
+	Organizations []RepoOrganization `json:"organizations"`
+}
+
+func (c *Client) patchRepositoryOrganizations(id string, orgs []RepoOrganization) (*Repository, error) {
+	resp := &repoInternal{}
+
+	if _, err := c.patchResource(fmt.Sprintf("%s/%s", reposEndpoint, id), &repoOrganizationsPatch{Organizations: orgs}, resp); err != nil {
+		return nil, fmt.Errorf("failed to update organizations of repo %s: %w", id, err)
+	}
+
+	return resp.toExternal()
+}
+
+// AssignRepositoryToOrganization grants organization {orgID} access to repository {repoID} with the given
+//
+//	GroupAssign mode, updating the mode if the organization already has access. groups lists the organization
+//	groups given access, and must be set for GroupAssignPartial and empty otherwise.
+//	Other organizations' assignments are preserved; the current list is re-read immediately before the update.
+func (c *Client) AssignRepositoryToOrganization(repoID, orgID, groupAssign string, groups []BaseInfo) (*Repository, error) {
+	if (groupAssign == GroupAssignPartial) != (len(groups) > 0) {
+		return nil, fmt.Errorf("groups must be given for, and only for, %s group assignment of repo %s to org %s",
+			GroupAssignPartial, repoID, orgID)
+	}
+
+	repo, err := c.GetRepository(repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment := RepoOrganization{ID: orgID, GroupAssign: groupAssign, Groups: groups}
+
+	orgs := make([]RepoOrganization, 0, len(repo.Organizations)+1)
+	found := false
+	for _, o := range repo.Organizations {
+		if o.ID == orgID {
+			o = assignment
+			found = true
+		}
+		orgs = append(orgs, o)
+	}
+	if !found {
+		orgs = append(orgs, assignment)
+	}
+
+	return c.patchRepositoryOrganizations(repoID, orgs)
+}
+
+// RevokeRepositoryFromOrganization removes organization {orgID}'s access to repository {repoID}.
+//
+//	Other organizations' assignments are preserved; the current list is re-read immediately before the update.
+//	Revoking an organization without access is not an error.
+func (c *Client) RevokeRepositoryFromOrganization(repoID, orgID string) (*Repository, error) {
+	repo, err := c.GetRepository(repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs := make([]RepoOrganization, 0, len(repo.Organizations))
+	for _, o := range repo.Organizations {
+		if o.ID != orgID {
+			orgs = append(orgs, o)
+		}
+	}
+
+	if len(orgs) == len(repo.Organizations) {
+		return repo, nil
+	}
+
+	return c.patchRepositoryOrganizations(repoID, orgs)
+}
@@ -0,0 +1,202 @@
+package tenablesc
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssignRepositoryToOrganization(t *testing.T) {
+	current := repoInternal{RepoBaseFields: RepoBaseFields{
+		BaseInfo:   BaseInfo{ID: "5"},
+		Type:       RepoTypeLocal,
+		DataFormat: RepoDataFormatIPv4,
+		Organizations: []RepoOrganization{
+			{ID: "1", GroupAssign: GroupAssignPartial, Groups: []BaseInfo{{ID: "10"}, {ID: "11"}}},
+			{ID: "2", GroupAssign: GroupAssignAll},
+		},
+	}}
+
+	var patched repoOrganizationsPatch
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+				t.Errorf("failed to decode patch: %v", err)
+			}
+			current.Organizations = patched.Organizations
+		}
+		return current
+	})
+
+	_, err := c.AssignRepositoryToOrganization("5", "2", GroupAssignPartial, []BaseInfo{{ID: "20"}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []RepoOrganization{
+		{ID: "1", GroupAssign: GroupAssignPartial, Groups: []BaseInfo{{ID: "10"}, {ID: "11"}}},
+		{ID: "2", GroupAssign: GroupAssignPartial, Groups: []BaseInfo{{ID: "20"}}},
+	}, patched.Organizations)
+
+	_, err = c.AssignRepositoryToOrganization("5", "3", GroupAssignFullAccess, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []RepoOrganization{
+		{ID: "1", GroupAssign: GroupAssignPartial, Groups: []BaseInfo{{ID: "10"}, {ID: "11"}}},
+		{ID: "2", GroupAssign: GroupAssignPartial, Groups: []BaseInfo{{ID: "20"}}},
+		{ID: "3", GroupAssign: GroupAssignFullAccess},
+	}, patched.Organizations)
+
+	_, err = c.AssignRepositoryToOrganization("5", "4", GroupAssignPartial, nil)
+	assert.Error(t, err)
+	_, err = c.AssignRepositoryToOrganization("5", "4", GroupAssignAll, []BaseInfo{{ID: "20"}})
+	assert.Error(t, err)
+}
+
+func parseTestRepo(t *testing.T, input string) (*Repository, error) {
+	var r repoInternal
+	if err := json.Unmarshal([]byte(input), &r); err != nil {
+		t.Fatalf("failed to decode test repository: %v", err)
+	}
+	return r.toExternal()
+}
+
+func TestRepoInternalToExternal(t *testing.T) {
+	repo, err := parseTestRepo(t, `{"id":"1","type":"Local","dataFormat":"IPv4","typeFields":{
+		"trendingDays":"30","ipRange":"10.0.0.0/24","ipCount":"256","lastGenerateNessusTime":"1700000000",
+		"nessusSchedule":{"type":"ical","start":"TZID=UTC:20240101T000000","repeatRule":"FREQ=DAILY;INTERVAL=1"}}}`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "30", repo.TrendingDays)
+		assert.Equal(t, "10.0.0.0/24", repo.IPRange)
+		assert.Equal(t, "256", repo.IPCount)
+		assert.Equal(t, "FREQ=DAILY;INTERVAL=1", repo.NessusSchedule.RepeatRule)
+		assert.Nil(t, repo.RemoteSchedule)
+		assert.Nil(t, repo.MDM)
+	}
+
+	repo, err = parseTestRepo(t, `{"id":"2","type":"Remote","dataFormat":"IPv6","typeFields":{
+		"ipRange":"::/0","remoteID":"7","remoteIP":"sc.example.com","lastSyncTime":"1700000000",
+		"remoteSchedule":{"type":"ical","start":"TZID=UTC:20240101T000000","repeatRule":"FREQ=WEEKLY;INTERVAL=1"}}}`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "::/0", repo.IPRange)
+		assert.Equal(t, "7", repo.RemoteID)
+		assert.Equal(t, "sc.example.com", repo.RemoteIP)
+		assert.Equal(t, "FREQ=WEEKLY;INTERVAL=1", repo.RemoteSchedule.RepeatRule)
+		assert.Equal(t, UnixEpochStringTime("1700000000"), repo.LastSyncTime)
+	}
+
+	repo, err = parseTestRepo(t, `{"id":"3","type":"Offline","dataFormat":"IPv4","typeFields":{"ipRange":"10.1.0.0/16","remoteID":"9"}}`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "10.1.0.0/16", repo.IPRange)
+		assert.Empty(t, repo.RemoteID, "remote fields are only read for Remote repositories")
+	}
+
+	repo, err = parseTestRepo(t, `{"id":"4","type":"Local","dataFormat":"mobile","typeFields":{
+		"trendingDays":"7","ipRange":"10.0.0.0/8","mdm":{"id":"5","name":"ActiveSync"},"scanSchedule":{"type":"never"}}}`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "7", repo.TrendingDays)
+		assert.Empty(t, repo.IPRange)
+		assert.Equal(t, "ActiveSync", repo.MDM.Name)
+		assert.Equal(t, "never", repo.MobileSchedule.Type)
+	}
+
+	repo, err = parseTestRepo(t, `{"id":"5","type":"Local","dataFormat":"agent","typeFields":{"trendingDays":"14","ipRange":"10.0.0.0/8"}}`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "14", repo.TrendingDays)
+		assert.Empty(t, repo.IPRange)
+	}
+
+	_, err = parseTestRepo(t, `{"id":"6","type":"Local","dataFormat":"universal","typeFields":{"trendingDays":"14"}}`)
+	assert.Error(t, err)
+	_, err = parseTestRepo(t, `{"id":"7","type":"Federated","dataFormat":"IPv4"}`)
+	assert.Error(t, err)
+}
+
+func TestGetAllRepositoriesTolerant(t *testing.T) {
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		return json.RawMessage(`[
+			{"id":"1","name":"local","type":"Local","dataFormat":"IPv4","typeFields":{"ipRange":"10.0.0.0/24"}},
+			{"id":"2","name":"federated","type":"Federated","dataFormat":"IPv4","typeFields":{"ipRange":"10.0.1.0/24"}}]`)
+	})
+
+	repos, convErrs, err := c.GetAllRepositoriesTolerant()
+	if !assert.NoError(t, err) || !assert.Len(t, repos, 2) {
+		return
+	}
+	assert.Equal(t, "10.0.0.0/24", repos[0].IPRange)
+	assert.Equal(t, "federated", repos[1].Name)
+	assert.Empty(t, repos[1].IPRange)
+	if assert.Len(t, convErrs, 1) {
+		assert.Contains(t, convErrs[0].Error(), "repository 2")
+	}
+
+	_, err = c.GetAllRepositories()
+	assert.Error(t, err)
+}
+
+func TestExportRepository(t *testing.T) {
+	archive := []byte{0x1f, 0x8b, 0x08, 0x00, '{', 0xff}
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		switch r.URL.Path {
+		case "/repository/1/export":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write(archive)
+		case "/repository/2/export":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(SCResponse{ErrorCode: 143, ErrorMsg: "repository is not local"})
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error_code":"403"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	c := NewClient(server.URL)
+
+	var out bytes.Buffer
+	if assert.NoError(t, c.ExportRepository("1", &out)) {
+		assert.Equal(t, "/repository/1/export", path)
+		assert.Equal(t, archive, out.Bytes())
+	}
+
+	out.Reset()
+	err := c.ExportRepository("2", &out)
+	var scErr SCError
+	if assert.ErrorAs(t, err, &scErr) {
+		assert.Equal(t, 143, scErr.SCErrorCode)
+		assert.Contains(t, err.Error(), "repository is not local")
+	}
+	assert.Zero(t, out.Len(), "SC errors are not written to the output")
+
+	err = c.ExportRepository("3", &out)
+	var notFound NotFoundError
+	if assert.ErrorAs(t, err, &notFound) {
+		assert.Equal(t, http.StatusForbidden, notFound.ResponseCode)
+		assert.Equal(t, `{"error_code":"403"}`, notFound.Body)
+	}
+	assert.Zero(t, out.Len())
+}
+
+func TestImportRepository(t *testing.T) {
+	var method, path string
+	var body map[string]interface{}
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		method, path = r.Method, r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode import request: %v", err)
+		}
+		return nil
+	})
+
+	if !assert.NoError(t, c.ImportRepository("4", "upload_abc123")) {
+		return
+	}
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, "/repository/4/import", path)
+	assert.Equal(t, map[string]interface{}{"file": "upload_abc123"}, body)
+}
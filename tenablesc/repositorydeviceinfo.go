@@ -0,0 +1,104 @@
+package tenablesc
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	repoDeviceInfoEndpoint = "/repository/%s/deviceInfo"
+	repoIPInfoEndpoint     = "/repository/%s/ipInfo"
+)
+
+// RepositoryDeviceInfo represents the per-host response structure for the deviceInfo and ipInfo
+// endpoints of https://docs.tenable.com/tenablesc/api/Repository.htm
+type RepositoryDeviceInfo struct {
+	IP            string              `json:"ip,omitempty"`
+	UUID          string              `json:"uuid,omitempty"`
+	RepositoryID  ProbablyString      `json:"repositoryID,omitempty"`
+	Repository    *BaseInfo           `json:"repository,omitempty"`
+	DNSName       string              `json:"dnsName,omitempty"`
+	NetBiosName   string              `json:"netbiosName,omitempty"`
+	MacAddress    string              `json:"macAddress,omitempty"`
+	OS            string              `json:"os,omitempty"`
+	OSCPE         string              `json:"osCPE,omitempty"`
+	BiosGUID      string              `json:"biosGUID,omitempty"`
+	McafeeGUID    string              `json:"mcafeeGUID,omitempty"`
+	TPMID         string              `json:"tpmID,omitempty"`
+	PolicyName    string              `json:"policyName,omitempty"`
+	PluginSet     string              `json:"pluginSet,omitempty"`
+	LastScan      UnixEpochStringTime `json:"lastScan,omitempty"`
+	LastAuthRun   UnixEpochStringTime `json:"lastAuthRun,omitempty"`
+	LastUnauthRun UnixEpochStringTime `json:"lastUnauthRun,omitempty"`
+	HasPassive    FakeBool            `json:"hasPassive,omitempty"`
+	HasCompliance FakeBool            `json:"hasCompliance,omitempty"`
+	Score         ProbablyString      `json:"score,omitempty"`
+	Total         ProbablyString      `json:"total,omitempty"`
+	// SeverityAll is a comma separated count of findings per severity, Info through Critical.
+	SeverityAll      string               `json:"severityAll,omitempty"`
+	SeverityInfo     ProbablyString       `json:"severityInfo,omitempty"`
+	SeverityLow      ProbablyString       `json:"severityLow,omitempty"`
+	SeverityMedium   ProbablyString       `json:"severityMedium,omitempty"`
+	SeverityHigh     ProbablyString       `json:"severityHigh,omitempty"`
+	SeverityCritical ProbablyString       `json:"severityCritical,omitempty"`
+	Links            []RepositoryInfoLink `json:"links,omitempty"`
+}
+
+// RepositoryInfoLink is an external lookup link SC attaches to device info, such as a whois or DNS query.
+type RepositoryInfoLink struct {
+	Name string `json:"name,omitempty"`
+	Link string `json:"link,omitempty"`
+}
+
+// SeverityCounts returns the number of findings on the host for each severity.
+func (d RepositoryDeviceInfo) SeverityCounts() (map[Severity]int, error) {
+	p := typedFieldParser{}
+
+	counts := map[Severity]int{
+		SeverityInfo:     p.int("severityInfo", d.SeverityInfo),
+		SeverityLow:      p.int("severityLow", d.SeverityLow),
+		SeverityMedium:   p.int("severityMedium", d.SeverityMedium),
+		SeverityHigh:     p.int("severityHigh", d.SeverityHigh),
+		SeverityCritical: p.int("severityCritical", d.SeverityCritical),
+	}
+	if p.err != nil {
+		return nil, fmt.Errorf("failed to parse device info for %s: %w", d.IP, p.err)
+	}
+
+	return counts, nil
+}
+
+func (c *Client) getRepositoryDeviceInfo(endpoint, repoID, param, value string) (*RepositoryDeviceInfo, error) {
+	query := url.Values{}
+	query.Add(param, value)
+
+	resp := &RepositoryDeviceInfo{}
+
+	if _, err := c.getResource(fmt.Sprintf("%s?%s", fmt.Sprintf(endpoint, repoID), query.Encode()), resp); err != nil {
+		return nil, fmt.Errorf("failed to get device info for %s %s in repository %s: %w", param, value, repoID, err)
+	}
+
+	return resp, nil
+}
+
+// GetRepositoryDeviceInfoByIP looks up the host with the given IP in repository {repoID}.
+func (c *Client) GetRepositoryDeviceInfoByIP(repoID, ip string) (*RepositoryDeviceInfo, error) {
+	return c.getRepositoryDeviceInfo(repoDeviceInfoEndpoint, repoID, "ip", ip)
+}
+
+// GetRepositoryDeviceInfoByDNSName looks up the host with the given DNS name in repository {repoID}.
+func (c *Client) GetRepositoryDeviceInfoByDNSName(repoID, dnsName string) (*RepositoryDeviceInfo, error) {
+	return c.getRepositoryDeviceInfo(repoDeviceInfoEndpoint, repoID, "dnsName", dnsName)
+}
+
+// GetRepositoryDeviceInfoByUUID looks up the host with the given agent or asset UUID in repository {repoID}.
+func (c *Client) GetRepositoryDeviceInfoByUUID(repoID, uuid string) (*RepositoryDeviceInfo, error) {
+	return c.getRepositoryDeviceInfo(repoDeviceInfoEndpoint, repoID, "uuid", uuid)
+}
+
+// GetRepositoryIPInfo looks up the host with the given IP using the older ipInfo endpoint,
+//
+//	for SC releases that predate deviceInfo.
+func (c *Client) GetRepositoryIPInfo(repoID, ip string) (*RepositoryDeviceInfo, error) {
+	return c.getRepositoryDeviceInfo(repoIPInfoEndpoint, repoID, "ip", ip)
+}
@@ -0,0 +1,70 @@
+package tenablesc
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRepositoryDeviceInfoQuery(t *testing.T) {
+	var path string
+	var query map[string][]string
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		path, query = r.URL.Path, r.URL.Query()
+		return RepositoryDeviceInfo{IP: "10.0.0.1", DNSName: "a&b+c.example.com"}
+	})
+
+	info, err := c.GetRepositoryDeviceInfoByDNSName("5", "a&b+c.example.com")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "10.0.0.1", info.IP)
+	assert.Equal(t, "/repository/5/deviceInfo", path)
+	assert.Equal(t, []string{"a&b+c.example.com"}, query["dnsName"])
+	if assert.Len(t, query["fields"], 1) {
+		fields := strings.Split(query["fields"][0], ",")
+		assert.Contains(t, fields, "dnsName")
+		assert.Contains(t, fields, "severityCritical")
+	}
+	assert.Len(t, query, 2)
+
+	_, err = c.GetRepositoryIPInfo("6", "10.0.0.1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "/repository/6/ipInfo", path)
+		assert.Equal(t, []string{"10.0.0.1"}, query["ip"])
+		assert.Len(t, query["fields"], 1)
+	}
+}
+
+func TestRepositoryDeviceInfoSeverityCounts(t *testing.T) {
+	counts, err := RepositoryDeviceInfo{
+		SeverityInfo:     "10",
+		SeverityLow:      "4",
+		SeverityMedium:   "3",
+		SeverityHigh:     "0",
+		SeverityCritical: "1",
+	}.SeverityCounts()
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[Severity]int{
+			SeverityInfo:     10,
+			SeverityLow:      4,
+			SeverityMedium:   3,
+			SeverityHigh:     0,
+			SeverityCritical: 1,
+		}, counts)
+	}
+
+	counts, err = RepositoryDeviceInfo{SeverityCritical: "2"}.SeverityCounts()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 0, counts[SeverityInfo], "missing counts are zero")
+		assert.Equal(t, 2, counts[SeverityCritical])
+	}
+
+	_, err = RepositoryDeviceInfo{IP: "10.0.0.1", SeverityLow: "4", SeverityHigh: "many"}.SeverityCounts()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "10.0.0.1")
+		assert.Contains(t, err.Error(), "severityHigh")
+	}
+}
@@ -0,0 +1,95 @@
+package tenablesc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Helpers shared by accept and recast risk rules.
+
+// Expiry is the expiration of a risk rule.
+//
+//	On the wire SC uses -1 for rules that never expire and epoch seconds otherwise;
+//	here an Expiry with a zero Time never expires. Rules hold a *Expiry so that leaving it nil
+//	omits the field from requests, keeping the existing expiry when updating.
+type Expiry struct {
+	Time time.Time
+}
+
+// ExpiresNever returns an Expiry for rules that do not lapse.
+func ExpiresNever() *Expiry {
+	return &Expiry{}
+}
+
+// ExpiresAt returns an Expiry at t; SC only tracks whole seconds.
+func ExpiresAt(t time.Time) *Expiry {
+	return &Expiry{Time: t.Truncate(time.Second)}
+}
+
+// IsNever reports whether the rule never expires.
+func (e Expiry) IsNever() bool {
+	return e.Time.IsZero()
+}
+
+// Before reports whether the rule expires before t; rules that never expire are never before t.
+func (e Expiry) Before(t time.Time) bool {
+	return !e.IsNever() && e.Time.Before(t)
+}
+
+// expiresWithin reports whether e expires in [now, now+window); nil and never expiries do not.
+func expiresWithin(e *Expiry, now time.Time, window time.Duration) bool {
+	return e != nil && !e.Before(now) && e.Before(now.Add(window))
+}
+
+func (e Expiry) String() string {
+	if e.IsNever() {
+		return "never"
+	}
+	return e.Time.String()
+}
+
+func (e Expiry) MarshalJSON() ([]byte, error) {
+	if e.IsNever() {
+		return json.Marshal("-1")
+	}
+	return json.Marshal(strconv.FormatInt(e.Time.Unix(), 10))
+}
+
+func (e *Expiry) UnmarshalJSON(data []byte) error {
+	var p ProbablyString
+	if err := json.Unmarshal(data, &p); err != nil {
+		return fmt.Errorf("failed to unmarshal expiry: %w", err)
+	}
+
+	t, err := p.AsTime()
+	if err != nil {
+		return fmt.Errorf("failed to parse expiry '%s': %w", p, err)
+	}
+
+	*e = Expiry{Time: t}
+	return nil
+}
+
+// RiskRuleTarget identifies a single plugin and host combination for bulk risk rule creation.
+type RiskRuleTarget struct {
+	PluginID string
+	// HostType may be 'all', 'asset', 'ip', or 'uuid'
+	HostType  string
+	HostValue string
+}
+
+// riskRuleApplyRequest is the body for the accept and recast risk rule apply endpoints;
+//
+//	a repository ID of 0 applies rules to all repositories.
+type riskRuleApplyRequest struct {
+	Repository BaseInfo `json:"repository"`
+}
+
+func newRiskRuleApplyRequest(repositoryID string) *riskRuleApplyRequest {
+	if repositoryID == "" {
+		repositoryID = "0"
+	}
+	return &riskRuleApplyRequest{Repository: BaseInfo{ID: ProbablyString(repositoryID)}}
+}
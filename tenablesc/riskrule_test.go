@@ -0,0 +1,58 @@
+package tenablesc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiryMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(ExpiresNever())
+	assert.NoError(t, err)
+	assert.Equal(t, `"-1"`, string(b))
+
+	b, err = json.Marshal(ExpiresAt(time.Unix(1700000000, 999)))
+	assert.NoError(t, err)
+	assert.Equal(t, `"1700000000"`, string(b))
+
+	// An unset expiry is left out of requests so updates keep the existing expiry.
+	b, err = json.Marshal(AcceptRiskRuleBaseFields{Comments: "c"})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"comments":"c"}`, string(b))
+}
+
+func TestExpiryUnmarshalJSON(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		want  Expiry
+	}{
+		{`"-1"`, Expiry{}},
+		{`-1`, Expiry{}},
+		{`"0"`, Expiry{}},
+		{`"1700000000"`, Expiry{Time: time.Unix(1700000000, 0)}},
+		{`1700000000`, Expiry{Time: time.Unix(1700000000, 0)}},
+	} {
+		var e Expiry
+		if assert.NoError(t, json.Unmarshal([]byte(tc.input), &e), tc.input) {
+			assert.True(t, tc.want.Time.Equal(e.Time), tc.input)
+			assert.Equal(t, tc.want.IsNever(), e.IsNever(), tc.input)
+		}
+	}
+
+	var e Expiry
+	assert.Error(t, json.Unmarshal([]byte(`"soon"`), &e))
+}
+
+func TestExpiresWithin(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	window := time.Hour
+
+	assert.False(t, expiresWithin(nil, now, window))
+	assert.False(t, expiresWithin(ExpiresNever(), now, window))
+	assert.False(t, expiresWithin(ExpiresAt(now.Add(-time.Second)), now, window), "already expired")
+	assert.True(t, expiresWithin(ExpiresAt(now), now, window))
+	assert.True(t, expiresWithin(ExpiresAt(now.Add(window-time.Second)), now, window))
+	assert.False(t, expiresWithin(ExpiresAt(now.Add(window)), now, window))
+}
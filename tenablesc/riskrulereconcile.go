@@ -0,0 +1,363 @@
+package tenablesc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DesiredRiskRuleTypeAccept = "accept"
+	DesiredRiskRuleTypeRecast = "recast"
+
+	// riskRuleAny is what SC reports for rules that are not restricted by port or protocol.
+	riskRuleAny = "any"
+)
+
+// managedRiskRuleMarker is appended to the comments of rules created by the reconciler;
+//
+//	it carries the rule key so the rule can be matched to its declaration on later runs.
+var managedRiskRuleMarker = regexp.MustCompile(`\[managed-rule:([^\]]+)\]\s*$`)
+
+// DesiredRiskRules is the document format read by LoadDesiredRiskRules.
+type DesiredRiskRules struct {
+	Rules []DesiredRiskRule `yaml:"rules"`
+}
+
+// DesiredRiskRule declares a single accept or recast risk rule.
+//
+//	Values are compared with what SC returns, so ports and protocols should be written as SC reports them.
+type DesiredRiskRule struct {
+	// Key uniquely identifies the rule across runs; it is recorded in the rule comments.
+	Key string `yaml:"key"`
+	// Type is 'accept' or 'recast'.
+	Type     string `yaml:"type"`
+	PluginID string `yaml:"plugin"`
+	// HostType may be 'all', 'asset', 'ip', or 'uuid'
+	HostType     string `yaml:"hostType"`
+	HostValue    string `yaml:"hostValue"`
+	RepositoryID string `yaml:"repository"`
+	// Port and Protocol default to 'any'.
+	Port     string `yaml:"port"`
+	Protocol string `yaml:"protocol"`
+	Comment  string `yaml:"comment"`
+	// Expires is left empty for rules that never expire.
+	Expires *time.Time `yaml:"expires"`
+	// NewSeverity is required for recast rules and may be a severity ID or name.
+	NewSeverity string `yaml:"newSeverity"`
+}
+
+// RiskRulePlan is the set of changes needed to make SC's managed risk rules match the desired rules.
+//
+//	Rules cannot be reliably patched, so changed rules appear in both the delete and create lists.
+type RiskRulePlan struct {
+	CreateAccept []*AcceptRiskRule
+	DeleteAccept []*AcceptRiskRule
+	CreateRecast []*RecastRiskRule
+	DeleteRecast []*RecastRiskRule
+	// Unchanged lists the keys of desired rules that already match.
+	Unchanged []string
+}
+
+// IsEmpty reports whether the plan makes no changes.
+func (p *RiskRulePlan) IsEmpty() bool {
+	return len(p.CreateAccept) == 0 && len(p.DeleteAccept) == 0 &&
+		len(p.CreateRecast) == 0 && len(p.DeleteRecast) == 0
+}
+
+// LoadDesiredRiskRules reads and validates a YAML document of the form `rules: [...]`.
+func LoadDesiredRiskRules(r io.Reader) ([]DesiredRiskRule, error) {
+	var doc DesiredRiskRules
+
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&doc); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to decode desired risk rules: %w", err)
+	}
+
+	if err := validateDesiredRiskRules(doc.Rules); err != nil {
+		return nil, err
+	}
+
+	return doc.Rules, nil
+}
+
+func validateDesiredRiskRules(rules []DesiredRiskRule) error {
+	seen := make(map[string]bool, len(rules))
+
+	for i, d := range rules {
+		switch {
+		case d.Key == "":
+			return fmt.Errorf("desired risk rule %d has no key", i)
+		case strings.ContainsAny(d.Key, "[]"):
+			return fmt.Errorf("desired risk rule key %s may not contain brackets", d.Key)
+		case seen[d.Key]:
+			return fmt.Errorf("desired risk rule key %s is duplicated", d.Key)
+		case d.PluginID == "":
+			return fmt.Errorf("desired risk rule %s has no plugin", d.Key)
+		case d.RepositoryID == "":
+			return fmt.Errorf("desired risk rule %s has no repository", d.Key)
+		}
+		seen[d.Key] = true
+
+		switch d.HostType {
+		case "all", "asset", "ip", "uuid":
+		default:
+			return fmt.Errorf("desired risk rule %s has unsupported hostType '%s'", d.Key, d.HostType)
+		}
+
+		switch d.Type {
+		case DesiredRiskRuleTypeAccept:
+		case DesiredRiskRuleTypeRecast:
+			if _, err := ParseSeverity(d.NewSeverity); err != nil {
+				return fmt.Errorf("desired risk rule %s: %w", d.Key, err)
+			}
+		default:
+			return fmt.Errorf("desired risk rule %s has unsupported type '%s'", d.Key, d.Type)
+		}
+	}
+
+	return nil
+}
+
+func (d DesiredRiskRule) comments() string {
+	marker := fmt.Sprintf("[managed-rule:%s]", d.Key)
+	if d.Comment == "" {
+		return marker
+	}
+	return d.Comment + "\n" + marker
+}
+
+func (d DesiredRiskRule) expiry() *Expiry {
+	if d.Expires == nil {
+		return ExpiresNever()
+	}
+	return ExpiresAt(*d.Expires)
+}
+
+func orAny(s string) string {
+	if s == "" {
+		return riskRuleAny
+	}
+	return s
+}
+
+func (d DesiredRiskRule) toAcceptRiskRule() *AcceptRiskRule {
+	return &AcceptRiskRule{
+		AcceptRiskRuleBaseFields: AcceptRiskRuleBaseFields{
+			Plugin:   &BaseInfo{ID: ProbablyString(d.PluginID)},
+			HostType: d.HostType,
+			Port:     orAny(d.Port),
+			Protocol: orAny(d.Protocol),
+			Comments: d.comments(),
+			Expires:  d.expiry(),
+		},
+		Repository: &BaseInfo{ID: ProbablyString(d.RepositoryID)},
+		HostValue:  d.HostValue,
+	}
+}
+
+func (d DesiredRiskRule) toRecastRiskRule() *RecastRiskRule {
+	// validated by validateDesiredRiskRules
+	severity, _ := ParseSeverity(d.NewSeverity)
+
+	return &RecastRiskRule{
+		RecastRiskRuleBaseFields: RecastRiskRuleBaseFields{
+			Plugin:   BaseInfo{ID: ProbablyString(d.PluginID)},
+			HostType: d.HostType,
+			Port:     orAny(d.Port),
+			Protocol: orAny(d.Protocol),
+			Comments: d.comments(),
+			Expires:  d.expiry(),
+		},
+		NewSeverity: severity,
+		Repository:  BaseInfo{ID: ProbablyString(d.RepositoryID)},
+		HostValue:   d.HostValue,
+	}
+}
+
+// managedRiskRuleKey extracts the reconciler key from rule comments, or returns an empty string for unmanaged rules.
+func managedRiskRuleKey(comments string) string {
+	m := managedRiskRuleMarker.FindStringSubmatch(comments)
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
+
+// expiriesEqual compares expiries, treating a missing expiry as never.
+func expiriesEqual(a, b *Expiry) bool {
+	if a == nil {
+		a = ExpiresNever()
+	}
+	if b == nil {
+		b = ExpiresNever()
+	}
+	return a.IsNever() == b.IsNever() && a.Time.Equal(b.Time)
+}
+
+func acceptRiskRulesEqual(want, got *AcceptRiskRule) bool {
+	if got.Plugin == nil || got.Repository == nil {
+		return false
+	}
+	return want.Plugin.ID == got.Plugin.ID &&
+		want.Repository.ID == got.Repository.ID &&
+		want.HostType == got.HostType &&
+		want.HostValue == got.HostValue &&
+		want.Port == orAny(got.Port) &&
+		want.Protocol == orAny(got.Protocol) &&
+		want.Comments == got.Comments &&
+		expiriesEqual(want.Expires, got.Expires)
+}
+
+func recastRiskRulesEqual(want, got *RecastRiskRule) bool {
+	return want.Plugin.ID == got.Plugin.ID &&
+		want.Repository.ID == got.Repository.ID &&
+		want.HostType == got.HostType &&
+		want.HostValue == got.HostValue &&
+		want.Port == orAny(got.Port) &&
+		want.Protocol == orAny(got.Protocol) &&
+		want.Comments == got.Comments &&
+		want.NewSeverity == got.NewSeverity &&
+		expiriesEqual(want.Expires, got.Expires)
+}
+
+// PlanRiskRules compares desired rules with existing rules and returns the changes needed to reconcile them.
+//
+//	Only rules carrying a reconciler marker in their comments are considered; rules created by hand are left alone.
+func PlanRiskRules(desired []DesiredRiskRule, accept []*AcceptRiskRule, recast []*RecastRiskRule) (*RiskRulePlan, error) {
+	if err := validateDesiredRiskRules(desired); err != nil {
+		return nil, err
+	}
+
+	plan := &RiskRulePlan{}
+
+	existingAccept := make(map[string][]*AcceptRiskRule)
+	for _, r := range accept {
+		if key := managedRiskRuleKey(r.Comments); key != "" {
+			existingAccept[key] = append(existingAccept[key], r)
+		}
+	}
+	existingRecast := make(map[string][]*RecastRiskRule)
+	for _, r := range recast {
+		if key := managedRiskRuleKey(r.Comments); key != "" {
+			existingRecast[key] = append(existingRecast[key], r)
+		}
+	}
+
+	for _, d := range desired {
+		switch d.Type {
+		case DesiredRiskRuleTypeAccept:
+			want := d.toAcceptRiskRule()
+			matched := false
+			for _, got := range existingAccept[d.Key] {
+				if !matched && acceptRiskRulesEqual(want, got) {
+					matched = true
+					continue
+				}
+				plan.DeleteAccept = append(plan.DeleteAccept, got)
+			}
+			delete(existingAccept, d.Key)
+			if matched {
+				plan.Unchanged = append(plan.Unchanged, d.Key)
+			} else {
+				plan.CreateAccept = append(plan.CreateAccept, want)
+			}
+
+		case DesiredRiskRuleTypeRecast:
+			want := d.toRecastRiskRule()
+			matched := false
+			for _, got := range existingRecast[d.Key] {
+				if !matched && recastRiskRulesEqual(want, got) {
+					matched = true
+					continue
+				}
+				plan.DeleteRecast = append(plan.DeleteRecast, got)
+			}
+			delete(existingRecast, d.Key)
+			if matched {
+				plan.Unchanged = append(plan.Unchanged, d.Key)
+			} else {
+				plan.CreateRecast = append(plan.CreateRecast, want)
+			}
+		}
+	}
+
+	// Anything left over is managed but no longer declared.
+	for _, rules := range existingAccept {
+		plan.DeleteAccept = append(plan.DeleteAccept, rules...)
+	}
+	for _, rules := range existingRecast {
+		plan.DeleteRecast = append(plan.DeleteRecast, rules...)
+	}
+	sort.SliceStable(plan.DeleteAccept, func(i, j int) bool { return plan.DeleteAccept[i].ID < plan.DeleteAccept[j].ID })
+	sort.SliceStable(plan.DeleteRecast, func(i, j int) bool { return plan.DeleteRecast[i].ID < plan.DeleteRecast[j].ID })
+
+	return plan, nil
+}
+
+// PlanRiskRuleReconciliation fetches all accept and recast risk rules and plans the changes needed to match desired.
+func (c *Client) PlanRiskRuleReconciliation(desired []DesiredRiskRule) (*RiskRulePlan, error) {
+	accept, err := c.GetAllAcceptRiskRules()
+	if err != nil {
+		return nil, err
+	}
+
+	recast, err := c.GetAllRecastRiskRules()
+	if err != nil {
+		return nil, err
+	}
+
+	return PlanRiskRules(desired, accept, recast)
+}
+
+// ApplyRiskRulePlan creates the new rules in the plan and then deletes the rules they supersede.
+//
+//	Creating first means a rule is never left without protection: if a key's create fails, its existing
+//	rules are kept rather than deleted. All other changes are attempted; failures are joined into the returned error.
+func (c *Client) ApplyRiskRulePlan(plan *RiskRulePlan) error {
+	var errs []error
+	failed := make(map[string]bool)
+
+	for _, r := range plan.CreateAccept {
+		if _, err := c.CreateAcceptRiskRule(r); err != nil {
+			key := managedRiskRuleKey(r.Comments)
+			failed[key] = true
+			errs = append(errs, fmt.Errorf("rule %s: %w", key, err))
+		}
+	}
+	for _, r := range plan.CreateRecast {
+		if _, err := c.CreateRecastRiskRule(r); err != nil {
+			key := managedRiskRuleKey(r.Comments)
+			failed[key] = true
+			errs = append(errs, fmt.Errorf("rule %s: %w", key, err))
+		}
+	}
+
+	for _, r := range plan.DeleteAccept {
+		if key := managedRiskRuleKey(r.Comments); !failed[key] {
+			if err := c.DeleteAcceptRiskRule(r.ID); err != nil {
+				errs = append(errs, fmt.Errorf("rule %s: %w", key, err))
+			}
+		}
+	}
+	for _, r := range plan.DeleteRecast {
+		if key := managedRiskRuleKey(r.Comments); !failed[key] {
+			if err := c.DeleteRecastRiskRule(r.ID); err != nil {
+				errs = append(errs, fmt.Errorf("rule %s: %w", key, err))
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("failed to apply risk rule plan: %w", err)
+	}
+
+	return nil
+}
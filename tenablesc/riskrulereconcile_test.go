@@ -0,0 +1,123 @@
+package tenablesc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDesiredRiskRules = `
+rules:
+  - key: web-tls
+    type: accept
+    plugin: "51192"
+    hostType: ip
+    hostValue: 10.0.0.1
+    repository: "1"
+    comment: self-signed by design
+  - key: legacy-ssh
+    type: recast
+    plugin: "70658"
+    hostType: all
+    repository: "1"
+    newSeverity: low
+    expires: 2030-01-01T00:00:00Z
+`
+
+func TestPlanRiskRules(t *testing.T) {
+	desired, err := LoadDesiredRiskRules(strings.NewReader(testDesiredRiskRules))
+	if !assert.NoError(t, err) || !assert.Len(t, desired, 2) {
+		return
+	}
+
+	unchanged := desired[0].toAcceptRiskRule()
+	unchanged.ID = "1"
+	stale := desired[0].toAcceptRiskRule()
+	stale.ID = "2"
+	removed := desired[0].toAcceptRiskRule()
+	removed.ID = "3"
+	removed.Comments = "[managed-rule:gone]"
+	manual := desired[0].toAcceptRiskRule()
+	manual.ID = "4"
+	manual.Comments = "added by hand"
+
+	changed := desired[1].toRecastRiskRule()
+	changed.ID = "10"
+	changed.NewSeverity = SeverityInfo
+
+	plan, err := PlanRiskRules(desired,
+		[]*AcceptRiskRule{unchanged, stale, removed, manual},
+		[]*RecastRiskRule{changed},
+	)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []string{"web-tls"}, plan.Unchanged)
+	assert.Empty(t, plan.CreateAccept)
+	assert.Equal(t, []*AcceptRiskRule{stale, removed}, plan.DeleteAccept)
+	assert.Equal(t, []*RecastRiskRule{changed}, plan.DeleteRecast)
+	if assert.Len(t, plan.CreateRecast, 1) {
+		assert.Equal(t, SeverityLow, plan.CreateRecast[0].NewSeverity)
+		assert.Equal(t, "legacy-ssh", managedRiskRuleKey(plan.CreateRecast[0].Comments))
+	}
+}
+
+func TestApplyRiskRulePlan(t *testing.T) {
+	desired, err := LoadDesiredRiskRules(strings.NewReader(testDesiredRiskRules))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	staleAccept := desired[0].toAcceptRiskRule()
+	staleAccept.ID = "1"
+	staleRecast := desired[1].toRecastRiskRule()
+	staleRecast.ID = "2"
+	removedRecast := desired[1].toRecastRiskRule()
+	removedRecast.ID = "3"
+	removedRecast.Comments = "[managed-rule:gone]"
+
+	plan := &RiskRulePlan{
+		CreateAccept: []*AcceptRiskRule{desired[0].toAcceptRiskRule()},
+		DeleteAccept: []*AcceptRiskRule{staleAccept},
+		CreateRecast: []*RecastRiskRule{desired[1].toRecastRiskRule()},
+		DeleteRecast: []*RecastRiskRule{staleRecast, removedRecast},
+	}
+
+	var calls []string
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		call := fmt.Sprintf("%s %s", r.Method, r.URL.Path)
+		calls = append(calls, call)
+
+		switch call {
+		case "POST " + acceptRiskRuleEndpoint:
+			return errors.New("plugin not found")
+		case "POST " + recastRiskRuleEndpoint:
+			created := &recastRiskRuleInternal{}
+			_ = json.NewDecoder(r.Body).Decode(created)
+			return []*recastRiskRuleInternal{created}
+		case "DELETE " + recastRiskRuleEndpoint + "/3":
+			return errors.New("rule locked")
+		}
+		return nil
+	})
+
+	err = c.ApplyRiskRulePlan(plan)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "rule web-tls: ")
+		assert.Contains(t, err.Error(), "rule gone: ")
+	}
+
+	// Creates run before deletes, and web-tls keeps its old rule because its replacement failed.
+	assert.Equal(t, []string{
+		"POST " + acceptRiskRuleEndpoint,
+		"POST " + recastRiskRuleEndpoint,
+		"DELETE " + recastRiskRuleEndpoint + "/2",
+		"DELETE " + recastRiskRuleEndpoint + "/3",
+	}, calls)
+}
@@ -2,10 +2,22 @@ package tenablesc
 
 import (
 	"fmt"
+
+	"github.com/palantir/tenablesc-client/schedule"
 )
 
 const scanEndpoint = "/scan"
 
+// Values for ScanSchedule.Type.
+const (
+	ScanScheduleTypeICal      = "ical"
+	ScanScheduleTypeDependent = "dependent"
+	ScanScheduleTypeNever     = "never"
+	ScanScheduleTypeRollover  = "rollover"
+	ScanScheduleTypeNow       = "now"
+	ScanScheduleTypeTemplate  = "template"
+)
+
 // Scan represents the request/response structure for https://docs.tenable.com/tenablesc/api/Scan.htm
 type Scan struct {
 	BaseInfo
@@ -58,6 +70,33 @@ type ScanSchedule struct {
 	Dependent   *ScanDependentSchedule `json:"dependent,omitempty"`
 }
 
+// NewICalScanSchedule builds an enabled ical ScanSchedule from a parsed schedule.
+func NewICalScanSchedule(s schedule.Schedule) *ScanSchedule {
+	return &ScanSchedule{
+		Type:       ScanScheduleTypeICal,
+		Start:      s.StartString(),
+		RepeatRule: s.RepeatRuleString(),
+		Enabled:    FakeTrue,
+	}
+}
+
+// ToSchedule parses Start and RepeatRule; it is only meaningful for ical schedules.
+func (s ScanSchedule) ToSchedule() (*schedule.Schedule, error) {
+	if s.Type != ScanScheduleTypeICal {
+		return nil, fmt.Errorf("schedule type %s has no ical start and repeat rule", s.Type)
+	}
+	return schedule.Parse(s.Start, s.RepeatRule)
+}
+
+// withoutReadOnlyFields returns a copy of the schedule with server-managed fields cleared.
+func (s ScanSchedule) withoutReadOnlyFields() *ScanSchedule {
+	s.ID = ""
+	s.ObjectType = ""
+	s.NextRun = 0
+	s.Dependent = nil
+	return &s
+}
+
 type ScanDependentSchedule struct {
 	BaseInfo
 	Status string `json:"status,omitempty"`
@@ -81,6 +120,32 @@ type ScanStartResult struct {
 	} `json:"scanResult"`
 }
 
+// scanCopyRequest is the body for https://docs.tenable.com/tenablesc/api/Scan.htm#scan_id_copy_POST
+type scanCopyRequest struct {
+	Name       string    `json:"name,omitempty"`
+	TargetUser *UserInfo `json:"targetUser,omitempty"`
+}
+
+type scanCopyResponse struct {
+	Scan Scan `json:"scan"`
+}
+
+// withoutReadOnlyFields returns a copy of the scan with server-managed fields cleared,
+//
+//	so a fetched scan can be submitted as a create request.
+func (s Scan) withoutReadOnlyFields() *Scan {
+	s.ID = ""
+	s.CreatedTime = ""
+	s.ModifiedTime = ""
+	s.Creator = nil
+	s.NumDependents = ""
+	s.Status = ""
+	if s.Schedule != nil {
+		s.Schedule = s.Schedule.withoutReadOnlyFields()
+	}
+	return &s
+}
+
 type orgScanResponse struct {
 	Manageable []*Scan `json:"manageable" tenable:"recurse"`
 	Usable     []*Scan `json:"usable" tenable:"recurse"`
@@ -119,10 +184,13 @@ func (c *Client) GetAllScans() ([]*Scan, error) {
 	return s, nil
 }
 
+// CreateScan creates a new scan; server-managed fields such as ID and CreatedTime are not sent,
+//
+//	so a scan fetched from the API may be passed directly.
 func (c *Client) CreateScan(s *Scan) (*Scan, error) {
 	resp := &Scan{}
 
-	if _, err := c.postResource(scanEndpoint, s, resp); err != nil {
+	if _, err := c.postResource(scanEndpoint, s.withoutReadOnlyFields(), resp); err != nil {
 		return nil, fmt.Errorf("failed to create scan: %w", err)
 	}
 
@@ -168,3 +236,38 @@ func (c *Client) DeleteScan(id string) error {
 
 	return nil
 }
+
+// CopyScan asks SC to copy scan {id} under a new name.
+//
+//	targetUserID may be empty to keep the copy with the current user.
+func (c *Client) CopyScan(id, name, targetUserID string) (*Scan, error) {
+	req := &scanCopyRequest{Name: name}
+	if targetUserID != "" {
+		req.TargetUser = &UserInfo{ID: ProbablyString(targetUserID)}
+	}
+
+	resp := &scanCopyResponse{}
+
+	if _, err := c.postResource(fmt.Sprintf("%s/%s/copy", scanEndpoint, id), req, resp); err != nil {
+		return nil, fmt.Errorf("failed to copy scan id %s: %w", id, err)
+	}
+
+	return &resp.Scan, nil
+}
+
+// CloneScan fetches scan {id}, applies overrides to it, and creates the result as a new scan.
+//
+//	Unlike CopyScan, targets, schedule and any other field can be changed before the new scan exists.
+func (c *Client) CloneScan(id string, overrides func(*Scan)) (*Scan, error) {
+	s, err := c.GetScan(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan to clone: %w", err)
+	}
+
+	clone := s.withoutReadOnlyFields()
+	if overrides != nil {
+		overrides(clone)
+	}
+
+	return c.CreateScan(clone)
+}
@@ -0,0 +1,53 @@
+package tenablesc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanScheduleWithoutReadOnlyFields(t *testing.T) {
+	s := ScanSchedule{
+		ID:          "1",
+		DependentID: "2",
+		Type:        ScanScheduleTypeICal,
+		Start:       "TZID=UTC:20240101T000000",
+		RepeatRule:  "FREQ=DAILY;INTERVAL=1",
+		Enabled:     FakeTrue,
+		ObjectType:  "scan",
+		NextRun:     1700000000,
+		Dependent:   &ScanDependentSchedule{BaseInfo: BaseInfo{ID: "2"}},
+	}
+
+	assert.Equal(t, &ScanSchedule{
+		DependentID: "2",
+		Type:        ScanScheduleTypeICal,
+		Start:       "TZID=UTC:20240101T000000",
+		RepeatRule:  "FREQ=DAILY;INTERVAL=1",
+		Enabled:     FakeTrue,
+	}, s.withoutReadOnlyFields())
+}
+
+func TestScanWithoutReadOnlyFields(t *testing.T) {
+	s := Scan{
+		BaseInfo:      BaseInfo{ID: "1", Name: "scan"},
+		CreatedTime:   "1700000000",
+		ModifiedTime:  "1700000001",
+		Creator:       &UserInfo{ID: "2"},
+		NumDependents: "3",
+		Status:        "0",
+		Owner:         &UserInfo{ID: "4"},
+		OwnerGroup:    &BaseInfo{ID: "5"},
+		Repository:    &BaseInfo{ID: "6"},
+		Schedule:      &ScanSchedule{ID: "7", Type: ScanScheduleTypeTemplate},
+	}
+
+	assert.Equal(t, &Scan{
+		BaseInfo:   BaseInfo{Name: "scan"},
+		Owner:      &UserInfo{ID: "4"},
+		OwnerGroup: &BaseInfo{ID: "5"},
+		Repository: &BaseInfo{ID: "6"},
+		Schedule:   &ScanSchedule{Type: ScanScheduleTypeTemplate},
+	}, s.withoutReadOnlyFields())
+	assert.Equal(t, ProbablyString("7"), s.Schedule.ID, "original schedule must not be modified")
+}
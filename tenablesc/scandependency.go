@@ -0,0 +1,182 @@
+package tenablesc
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ScanDependencyGraph describes the chains formed by scans with dependent schedules,
+//
+//	where each dependent scan runs after its parent scan completes.
+type ScanDependencyGraph struct {
+	// Scans holds every scan in the graph by ID.
+	Scans map[string]*Scan
+	// Parents maps a dependent scan ID to the ID of the scan it runs after.
+	Parents map[string]string
+	// Children maps a scan ID to the IDs of the scans that run after it, sorted.
+	Children map[string][]string
+	// Roots are the IDs of scans that start a chain: they have dependents but depend on nothing themselves.
+	Roots []string
+	// Orphans are the IDs of dependent scans whose parent is not among the known scans,
+	//  typically because it was deleted or is not visible to the current user.
+	Orphans []string
+	// Cycles lists each dependency cycle as the IDs of the scans involved, starting from the lowest sorting ID.
+	Cycles [][]string
+}
+
+// dependentParentID returns the ID of the scan s runs after, or an empty string for independent scans.
+func dependentParentID(s *Scan) string {
+	if s.Schedule == nil || s.Schedule.Type != ScanScheduleTypeDependent {
+		return ""
+	}
+	if s.Schedule.DependentID != "" {
+		return s.Schedule.DependentID
+	}
+	if s.Schedule.Dependent != nil {
+		return string(s.Schedule.Dependent.ID)
+	}
+	return ""
+}
+
+// BuildScanDependencyGraph computes the dependency graph of the given scans.
+func BuildScanDependencyGraph(scans []*Scan) *ScanDependencyGraph {
+	g := &ScanDependencyGraph{
+		Scans:    make(map[string]*Scan, len(scans)),
+		Parents:  make(map[string]string),
+		Children: make(map[string][]string),
+	}
+
+	for _, s := range scans {
+		g.Scans[string(s.ID)] = s
+	}
+
+	ids := make([]string, 0, len(g.Scans))
+	for id := range g.Scans {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		parent := dependentParentID(g.Scans[id])
+		if parent == "" {
+			continue
+		}
+		g.Parents[id] = parent
+		if _, ok := g.Scans[parent]; !ok {
+			g.Orphans = append(g.Orphans, id)
+			continue
+		}
+		g.Children[parent] = append(g.Children[parent], id)
+	}
+
+	for _, id := range ids {
+		if _, hasParent := g.Parents[id]; !hasParent && len(g.Children[id]) > 0 {
+			g.Roots = append(g.Roots, id)
+		}
+	}
+
+	// Each scan has at most one parent, so following parents from any scan either ends or loops.
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(ids))
+
+	for _, id := range ids {
+		var path []string
+		cycleAt := ""
+		for cur := id; ; {
+			if state[cur] == visiting {
+				cycleAt = cur
+				break
+			}
+			if state[cur] == done {
+				break
+			}
+			state[cur] = visiting
+			path = append(path, cur)
+
+			parent, ok := g.Parents[cur]
+			if _, known := g.Scans[parent]; !ok || !known {
+				break
+			}
+			cur = parent
+		}
+
+		if cycleAt != "" {
+			for i, p := range path {
+				if p == cycleAt {
+					g.Cycles = append(g.Cycles, normalizeCycle(path[i:]))
+					break
+				}
+			}
+		}
+
+		for _, p := range path {
+			state[p] = done
+		}
+	}
+
+	return g
+}
+
+// normalizeCycle rotates a cycle so it starts with its lowest sorting ID, keeping cycle output stable.
+func normalizeCycle(cycle []string) []string {
+	lowest := 0
+	for i, id := range cycle {
+		if id < cycle[lowest] {
+			lowest = i
+		}
+	}
+	return append(append([]string{}, cycle[lowest:]...), cycle[:lowest]...)
+}
+
+// Descendants returns the IDs of all scans that run, directly or transitively, after scan {id}, breadth first.
+func (g *ScanDependencyGraph) Descendants(id string) []string {
+	var out []string
+	seen := map[string]bool{id: true}
+	queue := append([]string{}, g.Children[id]...)
+
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		if seen[next] {
+			continue
+		}
+		seen[next] = true
+		out = append(out, next)
+		queue = append(queue, g.Children[next]...)
+	}
+
+	return out
+}
+
+// GetScanDependencyGraph builds the dependency graph across all scans visible to the current user.
+func (c *Client) GetScanDependencyGraph() (*ScanDependencyGraph, error) {
+	scans, err := c.GetAllScans()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scans for dependency graph: %w", err)
+	}
+
+	return BuildScanDependencyGraph(scans), nil
+}
+
+// CreateDependentScan creates s with a dependent schedule so it runs after scan {parentID} completes.
+//
+//	Any schedule already set on s is replaced; s itself is not modified.
+func (c *Client) CreateDependentScan(parentID string, s *Scan) (*Scan, error) {
+	dependent := *s
+	dependent.Schedule = &ScanSchedule{
+		Type:        ScanScheduleTypeDependent,
+		DependentID: parentID,
+		Enabled:     FakeTrue,
+	}
+
+	resp, err := c.CreateScan(&dependent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scan dependent on %s: %w", parentID, err)
+	}
+
+	return resp, nil
+}
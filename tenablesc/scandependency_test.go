@@ -0,0 +1,37 @@
+package tenablesc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func dependencyTestScan(id, parent string) *Scan {
+	s := &Scan{BaseInfo: BaseInfo{ID: ProbablyString(id)}}
+	if parent != "" {
+		s.Schedule = &ScanSchedule{Type: ScanScheduleTypeDependent, DependentID: parent}
+	} else {
+		s.Schedule = &ScanSchedule{Type: ScanScheduleTypeICal}
+	}
+	return s
+}
+
+func TestBuildScanDependencyGraph(t *testing.T) {
+	g := BuildScanDependencyGraph([]*Scan{
+		dependencyTestScan("1", ""),
+		dependencyTestScan("2", "1"),
+		dependencyTestScan("3", "2"),
+		dependencyTestScan("4", "1"),
+		dependencyTestScan("5", "99"),
+		dependencyTestScan("6", "7"),
+		dependencyTestScan("7", "8"),
+		dependencyTestScan("8", "6"),
+		dependencyTestScan("9", "8"),
+	})
+
+	assert.Equal(t, []string{"1"}, g.Roots)
+	assert.Equal(t, []string{"5"}, g.Orphans)
+	assert.Equal(t, [][]string{{"6", "7", "8"}}, g.Cycles)
+	assert.Equal(t, []string{"2", "4"}, g.Children["1"])
+	assert.Equal(t, []string{"2", "4", "3"}, g.Descendants("1"))
+}
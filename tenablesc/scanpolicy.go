@@ -33,6 +33,17 @@ type ScanPolicyFamilies struct {
 	Plugins []BaseInfo `json:"plugins,omitempty"`
 }
 
+// withoutReadOnlyFields returns a copy of the policy with server-managed fields cleared,
+//
+//	so a fetched policy can be submitted as a create request. Owner is kept, as it may be set on create.
+func (s ScanPolicy) withoutReadOnlyFields() *ScanPolicy {
+	s.ID = ""
+	s.CreatedTime = ""
+	s.ModifiedTime = ""
+	s.Creator = nil
+	return &s
+}
+
 type orgScanPolicyResponse struct {
 	Manageable []*ScanPolicy `json:"manageable" tenable:"recurse"`
 	Usable     []*ScanPolicy `json:"usable" tenable:"recurse"`
@@ -73,10 +84,13 @@ func (c *Client) GetAllScanPolicies() ([]*ScanPolicy, error) {
 	return s, nil
 }
 
+// CreateScanPolicy creates a new scan policy; server-managed fields such as ID and Creator are not sent,
+//
+//	so a policy fetched from the API may be passed directly.
 func (c *Client) CreateScanPolicy(s *ScanPolicy) (*ScanPolicy, error) {
 	resp := &ScanPolicy{}
 
-	if _, err := c.postResource(scanPolicyEndpoint, s, resp); err != nil {
+	if _, err := c.postResource(scanPolicyEndpoint, s.withoutReadOnlyFields(), resp); err != nil {
 		return nil, fmt.Errorf("failed to create scan policy: %w", err)
 	}
 
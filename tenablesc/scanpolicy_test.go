@@ -0,0 +1,24 @@
+package tenablesc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanPolicyWithoutReadOnlyFields(t *testing.T) {
+	p := ScanPolicy{
+		BaseInfo:       BaseInfo{ID: "1", Name: "policy"},
+		CreatedTime:    "1700000000",
+		ModifiedTime:   "1700000001",
+		PolicyTemplate: &BaseInfo{ID: "2"},
+		Owner:          &UserInfo{ID: "3"},
+		Creator:        &UserInfo{ID: "4"},
+	}
+
+	assert.Equal(t, &ScanPolicy{
+		BaseInfo:       BaseInfo{Name: "policy"},
+		PolicyTemplate: &BaseInfo{ID: "2"},
+		Owner:          &UserInfo{ID: "3"},
+	}, p.withoutReadOnlyFields())
+}
@@ -20,37 +20,146 @@ var DefaultTimeScope = time.Time{}
 
 var pkzipFileSignature = []byte{'\x50', '\x4b', '\x03', '\x04'}
 
+// ScanResultStatus is the lifecycle state of a scan result as reported by SC.
+type ScanResultStatus string
+
+const (
+	ScanResultStatusQueued               ScanResultStatus = "Queued"
+	ScanResultStatusPreparing            ScanResultStatus = "Preparing"
+	ScanResultStatusResolvingHostnames   ScanResultStatus = "Resolving Hostnames"
+	ScanResultStatusVerifyingTargets     ScanResultStatus = "Verifying Targets"
+	ScanResultStatusInitializingScanners ScanResultStatus = "Initializing Scanners"
+	ScanResultStatusRunning              ScanResultStatus = "Running"
+	ScanResultStatusPaused               ScanResultStatus = "Paused"
+	ScanResultStatusCompleted            ScanResultStatus = "Completed"
+	ScanResultStatusPartial              ScanResultStatus = "Partial"
+	ScanResultStatusError                ScanResultStatus = "Error"
+)
+
+// IsPausable reports whether SC will accept a pause request for a result in this state.
+func (s ScanResultStatus) IsPausable() bool {
+	switch s {
+	case ScanResultStatusQueued,
+		ScanResultStatusPreparing,
+		ScanResultStatusResolvingHostnames,
+		ScanResultStatusVerifyingTargets,
+		ScanResultStatusInitializingScanners,
+		ScanResultStatusRunning:
+		return true
+	}
+	return false
+}
+
+// IsResumable reports whether SC will accept a resume request for a result in this state.
+func (s ScanResultStatus) IsResumable() bool {
+	return s == ScanResultStatusPaused
+}
+
+// ScanResultImportStatus is the state of importing a scan result into its repository.
+type ScanResultImportStatus string
+
+const (
+	ScanResultImportStatusNoResults ScanResultImportStatus = "No Results"
+	ScanResultImportStatusImporting ScanResultImportStatus = "Importing"
+	ScanResultImportStatusFinished  ScanResultImportStatus = "Finished"
+	ScanResultImportStatusError     ScanResultImportStatus = "Error"
+)
+
 // ScanResult represents the request/response structure from https://docs.tenable.com/tenablesc/api/Scan-Result.htm
 type ScanResult struct {
 	BaseInfo
-	Status                 string         `json:"status,omitempty"`
-	Initiator              UserInfo       `json:"initiator,omitempty"`
-	Owner                  UserInfo       `json:"owner,omitempty"`
-	OwnerGroup             BaseInfo       `json:"ownerGroup,omitempty"`
-	Repository             BaseInfo       `json:"repository,omitempty"`
-	Scan                   BaseInfo       `json:"scan,omitempty"`
-	ImportStatus           string         `json:"importStatus,omitempty"`
-	ImportStart            ProbablyString `json:"importStart,omitempty"`
-	ImportFinish           ProbablyString `json:"importFinish,omitempty"`
-	ImportDuration         ProbablyString `json:"importDuration,omitempty"`
-	DownloadFormat         string         `json:"downloadFormat,omitempty"`
-	DataFormat             string         `json:"dataFormat,omitempty"`
-	ResultType             string         `json:"resultType,omitempty"`
-	ResultSource           string         `json:"resultSource,omitempty"`
-	ErrorDetails           string         `json:"errorDetails,omitempty"`
-	ImportErrorDetails     string         `json:"importErrorDetails,omitempty"`
-	TotalIPs               ProbablyString `json:"totalIPs,omitempty"`
-	ScannedIPs             ProbablyString `json:"scannedIPs,omitempty"`
-	StartTime              ProbablyString `json:"startTime,omitempty"`
-	FinishTime             ProbablyString `json:"finishTime,omitempty"`
-	ScanDuration           ProbablyString `json:"scanDuration,omitempty"`
-	CompletedIPs           ProbablyString `json:"completedIPs,omitempty"`
-	CompletedChecks        ProbablyString `json:"completedChecks,omitempty"`
-	TotalChecks            ProbablyString `json:"totalChecks,omitempty"`
-	AgentScanUUID          string         `json:"agentScanUUID,omitempty"`
-	AgentScanContainerUUID string         `json:"agentScanContainerUUID,omitempty"`
-	Job                    BaseInfo       `json:"job,omitempty"`
-	Details                string         `json:"details,omitempty"`
+	Status                 ScanResultStatus       `json:"status,omitempty"`
+	Initiator              UserInfo               `json:"initiator,omitempty"`
+	Owner                  UserInfo               `json:"owner,omitempty"`
+	OwnerGroup             BaseInfo               `json:"ownerGroup,omitempty"`
+	Repository             BaseInfo               `json:"repository,omitempty"`
+	Scan                   BaseInfo               `json:"scan,omitempty"`
+	ImportStatus           ScanResultImportStatus `json:"importStatus,omitempty"`
+	ImportStart            ProbablyString         `json:"importStart,omitempty"`
+	ImportFinish           ProbablyString         `json:"importFinish,omitempty"`
+	ImportDuration         ProbablyString         `json:"importDuration,omitempty"`
+	DownloadFormat         string                 `json:"downloadFormat,omitempty"`
+	DataFormat             string                 `json:"dataFormat,omitempty"`
+	ResultType             string                 `json:"resultType,omitempty"`
+	ResultSource           string                 `json:"resultSource,omitempty"`
+	ErrorDetails           string                 `json:"errorDetails,omitempty"`
+	ImportErrorDetails     string                 `json:"importErrorDetails,omitempty"`
+	TotalIPs               ProbablyString         `json:"totalIPs,omitempty"`
+	ScannedIPs             ProbablyString         `json:"scannedIPs,omitempty"`
+	StartTime              ProbablyString         `json:"startTime,omitempty"`
+	FinishTime             ProbablyString         `json:"finishTime,omitempty"`
+	ScanDuration           ProbablyString         `json:"scanDuration,omitempty"`
+	CompletedIPs           ProbablyString         `json:"completedIPs,omitempty"`
+	CompletedChecks        ProbablyString         `json:"completedChecks,omitempty"`
+	TotalChecks            ProbablyString         `json:"totalChecks,omitempty"`
+	AgentScanUUID          string                 `json:"agentScanUUID,omitempty"`
+	AgentScanContainerUUID string                 `json:"agentScanContainerUUID,omitempty"`
+	Job                    BaseInfo               `json:"job,omitempty"`
+	Details                string                 `json:"details,omitempty"`
+}
+
+// IsPausable reports whether the scan result is currently in a state that can be paused.
+func (s ScanResult) IsPausable() bool {
+	return s.Status.IsPausable()
+}
+
+// IsResumable reports whether the scan result is currently paused and can be resumed.
+func (s ScanResult) IsResumable() bool {
+	return s.Status.IsResumable()
+}
+
+// ScanResultProgress is a typed view of the status, timing and count fields of a ScanResult.
+type ScanResultProgress struct {
+	Status          ScanResultStatus
+	ImportStatus    ScanResultImportStatus
+	StartTime       time.Time
+	FinishTime      time.Time
+	ScanDuration    time.Duration
+	ImportStart     time.Time
+	ImportFinish    time.Time
+	ImportDuration  time.Duration
+	TotalIPs        int
+	ScannedIPs      int
+	CompletedIPs    int
+	TotalChecks     int
+	CompletedChecks int
+	// PercentComplete is computed from checks where available, falling back to IPs.
+	PercentComplete float64
+}
+
+// Progress parses the stringy status, timing and count fields into a ScanResultProgress.
+func (s ScanResult) Progress() (*ScanResultProgress, error) {
+	p := typedFieldParser{}
+
+	progress := &ScanResultProgress{
+		Status:          s.Status,
+		ImportStatus:    s.ImportStatus,
+		StartTime:       p.time("startTime", s.StartTime),
+		FinishTime:      p.time("finishTime", s.FinishTime),
+		ScanDuration:    p.duration("scanDuration", s.ScanDuration),
+		ImportStart:     p.time("importStart", s.ImportStart),
+		ImportFinish:    p.time("importFinish", s.ImportFinish),
+		ImportDuration:  p.duration("importDuration", s.ImportDuration),
+		TotalIPs:        p.int("totalIPs", s.TotalIPs),
+		ScannedIPs:      p.int("scannedIPs", s.ScannedIPs),
+		CompletedIPs:    p.int("completedIPs", s.CompletedIPs),
+		TotalChecks:     p.int("totalChecks", s.TotalChecks),
+		CompletedChecks: p.int("completedChecks", s.CompletedChecks),
+	}
+	if p.err != nil {
+		return nil, fmt.Errorf("failed to parse scan result %s: %w", s.ID, p.err)
+	}
+
+	switch {
+	case s.Status == ScanResultStatusCompleted:
+		progress.PercentComplete = 100
+	case progress.TotalChecks > 0:
+		progress.PercentComplete = percent(progress.CompletedChecks, progress.TotalChecks)
+	default:
+		progress.PercentComplete = percent(progress.CompletedIPs, progress.TotalIPs)
+	}
+
+	return progress, nil
 }
 
 type scanResultInternal struct {
@@ -129,6 +238,28 @@ func (c *Client) StopScanResult(id string) error {
 	return nil
 }
 
+// PauseScanResult Pauses the running Scan Result associated with {id}.
+// NOTE: This endpoint is not applicable for Agent Sync Results.
+// ref: https://docs.tenable.com/tenablesc/api/Scan-Result.htm#ScanResultRESTReference-/scanResult/{id}/pause
+func (c *Client) PauseScanResult(id string) error {
+	if _, err := c.postResource(fmt.Sprintf("%s/%s/pause", scanResultEndpoint, id), nil, nil); err != nil {
+		return fmt.Errorf("unable to pause scan result with id %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// ResumeScanResult Resumes the paused Scan Result associated with {id}.
+// NOTE: This endpoint is not applicable for Agent Sync Results.
+// ref: https://docs.tenable.com/tenablesc/api/Scan-Result.htm#ScanResultRESTReference-/scanResult/{id}/resume
+func (c *Client) ResumeScanResult(id string) error {
+	if _, err := c.postResource(fmt.Sprintf("%s/%s/resume", scanResultEndpoint, id), nil, nil); err != nil {
+		return fmt.Errorf("unable to resume scan result with id %s: %w", id, err)
+	}
+
+	return nil
+}
+
 func (c *Client) DownloadScanResult(id string) ([]byte, error) {
 
 	possiblyZippedStream, err := c.internalDownloadScanResult(id)
@@ -0,0 +1,53 @@
+package tenablesc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanResultProgress(t *testing.T) {
+	result := ScanResult{
+		Status:          ScanResultStatusRunning,
+		ImportStatus:    ScanResultImportStatusNoResults,
+		StartTime:       "1700000000",
+		FinishTime:      "-1",
+		ScanDuration:    "120",
+		TotalIPs:        "10",
+		ScannedIPs:      "4",
+		CompletedIPs:    "2",
+		TotalChecks:     "",
+		CompletedChecks: "",
+	}
+
+	progress, err := result.Progress()
+	if assert.NoError(t, err) {
+		assert.Equal(t, ScanResultStatusRunning, progress.Status)
+		assert.Equal(t, ScanResultImportStatusNoResults, progress.ImportStatus)
+		assert.Equal(t, time.Unix(1700000000, 0), progress.StartTime)
+		assert.True(t, progress.FinishTime.IsZero())
+		assert.Equal(t, 2*time.Minute, progress.ScanDuration)
+		assert.Equal(t, 4, progress.ScannedIPs)
+		assert.Equal(t, 20.0, progress.PercentComplete, "without check counts progress is by completed IPs")
+	}
+
+	result.TotalChecks = "200"
+	result.CompletedChecks = "150"
+	progress, err = result.Progress()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 75.0, progress.PercentComplete, "check counts are preferred over IP counts")
+	}
+
+	result.Status = ScanResultStatusCompleted
+	progress, err = result.Progress()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 100.0, progress.PercentComplete)
+	}
+
+	result.ImportDuration = "soon"
+	_, err = result.Progress()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "importDuration")
+	}
+}
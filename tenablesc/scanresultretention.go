@@ -0,0 +1,225 @@
+package tenablesc
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// retentionListStart is used as the lower bound when listing results for retention;
+//
+//	SC defaults to the last 30 days when no start time is passed, which would hide exactly the results we want.
+var retentionListStart = time.Unix(1, 0)
+
+// ScanResultRetentionPolicy describes which scan results SweepScanResults should remove.
+//
+//	A finished result is deleted once it is older than the newest KeepLastPerScan completed results of its scan
+//	and older than the age limit that applies to it; a zero policy deletes nothing.
+//	Results that are still running are never deleted.
+type ScanResultRetentionPolicy struct {
+	// KeepLastPerScan always retains the newest N Completed results of each scan regardless of age,
+	//  along with any Error or Partial results newer than them.
+	KeepLastPerScan int
+	// MaxAge retains results that finished within this duration.
+	MaxAge time.Duration
+	// KeepFailedFor replaces MaxAge for results with an Error or Partial status.
+	//  When unset, failed results are aged like any other result.
+	KeepFailedFor time.Duration
+	// ExcludeScans lists scan names whose results are never deleted.
+	ExcludeScans []string
+	// DryRun computes and reports the plan without deleting anything.
+	DryRun bool
+	// Concurrency bounds the number of simultaneous delete requests; values below 1 mean 1.
+	Concurrency int
+}
+
+// ScanResultRetentionDecision records what the retention plan decided for a single scan result.
+type ScanResultRetentionDecision struct {
+	ScanResult *ScanResult
+	Delete     bool
+	Reason     string
+	// Err is set when a planned deletion was attempted and failed.
+	Err error
+}
+
+// ScanResultRetentionReport summarizes a retention sweep.
+type ScanResultRetentionReport struct {
+	DryRun bool
+	// Deleted holds results that were removed, or would have been removed in a dry run.
+	Deleted  []ScanResultRetentionDecision
+	Retained []ScanResultRetentionDecision
+	// Failed holds results that were planned for deletion but could not be removed.
+	Failed []ScanResultRetentionDecision
+}
+
+func scanResultRetentionKey(r *ScanResult) string {
+	if r.Scan.ID != "" && r.Scan.ID != "-1" && r.Scan.ID != "0" {
+		return "id:" + string(r.Scan.ID)
+	}
+	return "name:" + scanResultScanName(r)
+}
+
+func scanResultScanName(r *ScanResult) string {
+	if r.Scan.Name != "" {
+		return r.Scan.Name
+	}
+	return r.Name
+}
+
+// scanResultRetentionTime is the time a result is aged from; the finish time where known, otherwise the start.
+//
+//	Only the two times are parsed, so malformed progress counters elsewhere in the result don't block retention.
+func scanResultRetentionTime(r *ScanResult) (time.Time, error) {
+	p := typedFieldParser{}
+	start := p.time("startTime", r.StartTime)
+	finish := p.time("finishTime", r.FinishTime)
+	if p.err != nil {
+		return time.Time{}, p.err
+	}
+	if !finish.IsZero() {
+		return finish, nil
+	}
+	return start, nil
+}
+
+// PlanScanResultRetention decides which of the given results the policy would delete as of now.
+//
+//	Decisions are returned newest first within each scan.
+func PlanScanResultRetention(results []*ScanResult, policy ScanResultRetentionPolicy, now time.Time) ([]ScanResultRetentionDecision, error) {
+	excluded := make(map[string]bool, len(policy.ExcludeScans))
+	for _, name := range policy.ExcludeScans {
+		excluded[name] = true
+	}
+
+	type agedResult struct {
+		result *ScanResult
+		at     time.Time
+	}
+
+	var keys []string
+	byScan := make(map[string][]agedResult)
+
+	for _, r := range results {
+		at, err := scanResultRetentionTime(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine age of scan result %s: %w", r.ID, err)
+		}
+		key := scanResultRetentionKey(r)
+		if _, ok := byScan[key]; !ok {
+			keys = append(keys, key)
+		}
+		byScan[key] = append(byScan[key], agedResult{result: r, at: at})
+	}
+
+	decisions := make([]ScanResultRetentionDecision, 0, len(results))
+
+	for _, key := range keys {
+		scanResults := byScan[key]
+		sort.SliceStable(scanResults, func(i, j int) bool {
+			return scanResults[i].at.After(scanResults[j].at)
+		})
+
+		completed := 0
+		for _, ar := range scanResults {
+			r := ar.result
+			d := ScanResultRetentionDecision{ScanResult: r}
+			age := now.Sub(ar.at)
+
+			maxAge := policy.MaxAge
+			if policy.KeepFailedFor > 0 && scanResultIsFailed(r.Status) {
+				maxAge = policy.KeepFailedFor
+			}
+
+			switch {
+			case excluded[scanResultScanName(r)]:
+				d.Reason = "scan is excluded"
+			case !scanResultIsFinished(r.Status):
+				d.Reason = fmt.Sprintf("status %s is not final", r.Status)
+			case ar.at.IsZero():
+				d.Reason = "result has no start or finish time"
+			case policy.KeepLastPerScan > 0 && completed < policy.KeepLastPerScan:
+				d.Reason = fmt.Sprintf("within newest %d completed results of scan", policy.KeepLastPerScan)
+			case maxAge > 0 && age < maxAge:
+				d.Reason = fmt.Sprintf("younger than %s", maxAge)
+			case maxAge <= 0 && policy.KeepLastPerScan <= 0:
+				d.Reason = "no deletion rule applies"
+			default:
+				d.Delete = true
+				d.Reason = fmt.Sprintf("aged %s", age.Truncate(time.Second))
+			}
+
+			if r.Status == ScanResultStatusCompleted {
+				completed++
+			}
+
+			decisions = append(decisions, d)
+		}
+	}
+
+	return decisions, nil
+}
+
+func scanResultIsFinished(s ScanResultStatus) bool {
+	switch s {
+	case ScanResultStatusCompleted, ScanResultStatusPartial, ScanResultStatusError:
+		return true
+	}
+	return false
+}
+
+func scanResultIsFailed(s ScanResultStatus) bool {
+	return s == ScanResultStatusError || s == ScanResultStatusPartial
+}
+
+// SweepScanResults lists all scan results, plans deletions according to the policy,
+//
+//	and deletes the planned results unless the policy is a dry run.
+//	Individual deletion failures are reported in the returned report rather than as an error.
+func (c *Client) SweepScanResults(policy ScanResultRetentionPolicy) (*ScanResultRetentionReport, error) {
+	now := time.Now()
+
+	results, err := c.GetAllScanResultsByTime(retentionListStart, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scan results for retention: %w", err)
+	}
+
+	decisions, err := PlanScanResultRetention(results, policy, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan scan result retention: %w", err)
+	}
+
+	report := &ScanResultRetentionReport{DryRun: policy.DryRun}
+
+	var toDelete []int
+	for i, d := range decisions {
+		if d.Delete {
+			toDelete = append(toDelete, i)
+		} else {
+			report.Retained = append(report.Retained, d)
+		}
+	}
+
+	if !policy.DryRun {
+		c.deleteScanResultsForRetention(decisions, toDelete, policy.Concurrency)
+	}
+
+	for _, i := range toDelete {
+		if decisions[i].Err != nil {
+			report.Failed = append(report.Failed, decisions[i])
+		} else {
+			report.Deleted = append(report.Deleted, decisions[i])
+		}
+	}
+
+	return report, nil
+}
+
+// deleteScanResultsForRetention deletes the indexed decisions with at most concurrency requests in flight,
+//
+//	recording any failure on the decision itself.
+func (c *Client) deleteScanResultsForRetention(decisions []ScanResultRetentionDecision, indexes []int, concurrency int) {
+	forEachConcurrently(len(indexes), concurrency, func(i int) {
+		d := &decisions[indexes[i]]
+		d.Err = c.DeleteScanResult(string(d.ScanResult.ID))
+	})
+}
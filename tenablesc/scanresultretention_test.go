@@ -0,0 +1,96 @@
+package tenablesc
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func retentionTestResult(id, scanID string, status ScanResultStatus, finished time.Time) *ScanResult {
+	return &ScanResult{
+		BaseInfo:   BaseInfo{ID: ProbablyString(id), Name: "scan " + scanID},
+		Scan:       BaseInfo{ID: ProbablyString(scanID), Name: "scan " + scanID},
+		Status:     status,
+		FinishTime: ProbablyString(fmt.Sprintf("%d", finished.Unix())),
+	}
+}
+
+func deletedIDs(decisions []ScanResultRetentionDecision) []string {
+	var ids []string
+	for _, d := range decisions {
+		if d.Delete {
+			ids = append(ids, string(d.ScanResult.ID))
+		}
+	}
+	return ids
+}
+
+func TestPlanScanResultRetention(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	day := 24 * time.Hour
+
+	results := []*ScanResult{
+		retentionTestResult("1", "10", ScanResultStatusCompleted, now.Add(-1*day)),
+		retentionTestResult("2", "10", ScanResultStatusCompleted, now.Add(-40*day)),
+		retentionTestResult("3", "10", ScanResultStatusCompleted, now.Add(-50*day)),
+		retentionTestResult("4", "10", ScanResultStatusError, now.Add(-60*day)),
+		retentionTestResult("5", "20", ScanResultStatusCompleted, now.Add(-90*day)),
+		retentionTestResult("6", "30", ScanResultStatusCompleted, now.Add(-90*day)),
+		retentionTestResult("7", "30", ScanResultStatusRunning, now.Add(-90*day)),
+	}
+
+	decisions, err := PlanScanResultRetention(results, ScanResultRetentionPolicy{}, now)
+	assert.NoError(t, err)
+	assert.Empty(t, deletedIDs(decisions))
+
+	decisions, err = PlanScanResultRetention(results, ScanResultRetentionPolicy{
+		KeepLastPerScan: 1,
+		MaxAge:          30 * day,
+		KeepFailedFor:   90 * day,
+		ExcludeScans:    []string{"scan 20"},
+	}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "3"}, deletedIDs(decisions))
+
+	decisions, err = PlanScanResultRetention(results, ScanResultRetentionPolicy{
+		MaxAge: 45 * day,
+	}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3", "4", "5", "6"}, deletedIDs(decisions))
+}
+
+func TestPlanScanResultRetentionKeepsLastCompleted(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	day := 24 * time.Hour
+
+	results := []*ScanResult{
+		retentionTestResult("1", "10", ScanResultStatusError, now.Add(-40*day)),
+		retentionTestResult("2", "10", ScanResultStatusPartial, now.Add(-45*day)),
+		retentionTestResult("3", "10", ScanResultStatusCompleted, now.Add(-50*day)),
+		retentionTestResult("4", "10", ScanResultStatusCompleted, now.Add(-60*day)),
+	}
+
+	decisions, err := PlanScanResultRetention(results, ScanResultRetentionPolicy{
+		KeepLastPerScan: 1,
+		MaxAge:          30 * day,
+	}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"4"}, deletedIDs(decisions))
+}
+
+func TestPlanScanResultRetentionIgnoresProgressCounters(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	result := retentionTestResult("1", "10", ScanResultStatusCompleted, now.Add(-48*time.Hour))
+	result.TotalIPs = "not a number"
+
+	decisions, err := PlanScanResultRetention([]*ScanResult{result}, ScanResultRetentionPolicy{MaxAge: time.Hour}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, deletedIDs(decisions))
+
+	result.FinishTime = "yesterday"
+	_, err = PlanScanResultRetention([]*ScanResult{result}, ScanResultRetentionPolicy{MaxAge: time.Hour}, now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,96 @@
+package tenablesc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity is SC's vulnerability severity, represented by its API ID ("0" through "4").
+//
+//	Severities order naturally from Info to Critical; use Compare rather than string comparison.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "0"
+	SeverityLow      Severity = "1"
+	SeverityMedium   Severity = "2"
+	SeverityHigh     Severity = "3"
+	SeverityCritical Severity = "4"
+)
+
+// AllSeverities lists every severity from lowest to highest.
+var AllSeverities = []Severity{SeverityInfo, SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical}
+
+var severityNames = map[Severity]string{
+	SeverityInfo:     "Info",
+	SeverityLow:      "Low",
+	SeverityMedium:   "Medium",
+	SeverityHigh:     "High",
+	SeverityCritical: "Critical",
+}
+
+// ParseSeverity accepts either an SC severity ID or a severity name, case-insensitively.
+func ParseSeverity(s string) (Severity, error) {
+	trimmed := strings.TrimSpace(s)
+
+	if _, ok := severityNames[Severity(trimmed)]; ok {
+		return Severity(trimmed), nil
+	}
+
+	if strings.EqualFold(trimmed, "informational") {
+		return SeverityInfo, nil
+	}
+	for sev, name := range severityNames {
+		if strings.EqualFold(trimmed, name) {
+			return sev, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown severity '%s'", s)
+}
+
+// SeverityFromBaseInfo parses the severity references SC embeds in responses, preferring the ID.
+func SeverityFromBaseInfo(b BaseInfo) (Severity, error) {
+	if b.ID != "" {
+		return ParseSeverity(string(b.ID))
+	}
+	return ParseSeverity(b.Name)
+}
+
+// IsValid reports whether s is one of the five SC severities.
+func (s Severity) IsValid() bool {
+	_, ok := severityNames[s]
+	return ok
+}
+
+// Name returns the display name of the severity, e.g. "Critical".
+func (s Severity) Name() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("Unknown(%s)", string(s))
+}
+
+// Level returns the numeric severity, 0 (Info) through 4 (Critical), or -1 if s is not valid.
+func (s Severity) Level() int {
+	if !s.IsValid() {
+		return -1
+	}
+	return int(s[0] - '0')
+}
+
+// Compare returns -1, 0 or 1 as s is lower than, equal to, or higher than other.
+func (s Severity) Compare(other Severity) int {
+	switch a, b := s.Level(), other.Level(); {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// BaseInfo renders the severity as the ID reference the API expects on input.
+func (s Severity) BaseInfo() BaseInfo {
+	return BaseInfo{ID: ProbablyString(s)}
+}
@@ -0,0 +1,51 @@
+package tenablesc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSeverity(t *testing.T) {
+	for input, want := range map[string]Severity{
+		"0":             SeverityInfo,
+		"4":             SeverityCritical,
+		" 3 ":           SeverityHigh,
+		"info":          SeverityInfo,
+		"Informational": SeverityInfo,
+		"LOW":           SeverityLow,
+		"medium":        SeverityMedium,
+		"Critical":      SeverityCritical,
+	} {
+		got, err := ParseSeverity(input)
+		if assert.NoError(t, err, input) {
+			assert.Equal(t, want, got, input)
+		}
+	}
+
+	for _, input := range []string{"", "5", "-1", "severe"} {
+		_, err := ParseSeverity(input)
+		assert.Error(t, err, input)
+	}
+
+	s, err := SeverityFromBaseInfo(BaseInfo{Name: "High"})
+	assert.NoError(t, err)
+	assert.Equal(t, SeverityHigh, s)
+}
+
+func TestSeverityCompare(t *testing.T) {
+	assert.Equal(t, -1, SeverityLow.Compare(SeverityCritical))
+	assert.Equal(t, 0, SeverityMedium.Compare(SeverityMedium))
+	assert.Equal(t, 1, SeverityHigh.Compare(SeverityInfo))
+	assert.Equal(t, -1, Severity("9").Compare(SeverityInfo), "invalid severities rank lowest")
+	assert.Equal(t, -1, Severity("9").Level())
+}
+
+func TestSeverityFilters(t *testing.T) {
+	assert.Equal(t, AnalysisFilter{FilterName: "severity", Operator: "=", Value: "4,1"}, SeverityFilter(SeverityCritical, SeverityLow))
+	assert.Equal(t, "", SeverityFilter().Value)
+
+	assert.Equal(t, "3,4", MinimumSeverityFilter(SeverityHigh).Value)
+	assert.Equal(t, "4", MinimumSeverityFilter(SeverityCritical).Value)
+	assert.Equal(t, "0,1,2,3,4", MinimumSeverityFilter(SeverityInfo).Value)
+}
@@ -0,0 +1,212 @@
+package tenablesc
+
+import (
+	"fmt"
+	"strings"
+)
+
+const ticketEndpoint = "/ticket"
+
+// TicketStatus is the workflow state of a ticket.
+type TicketStatus string
+
+const (
+	TicketStatusAssigned      TicketStatus = "assigned"
+	TicketStatusResolved      TicketStatus = "resolved"
+	TicketStatusFeedback      TicketStatus = "feedback"
+	TicketStatusNotApplicable TicketStatus = "na"
+	TicketStatusDuplicate     TicketStatus = "duplicate"
+	TicketStatusClosed        TicketStatus = "closed"
+)
+
+// IsOpen reports whether the ticket still needs action from its assignee.
+func (s TicketStatus) IsOpen() bool {
+	return s == TicketStatusAssigned || s == TicketStatusFeedback
+}
+
+// Ticket represents the request/response structure for https://docs.tenable.com/tenablesc/api/Ticket.htm
+//
+//	SC has no API to delete tickets; close them instead. Purging closed tickets is an administrator task.
+type Ticket struct {
+	BaseInfo
+	// Classification may be e.g. 'Patch', 'Configuration', 'False Positive' or 'Other'.
+	Classification string       `json:"classification,omitempty"`
+	Status         TicketStatus `json:"status,omitempty"`
+	// Notes is a single free-text field; AddTicketNote appends to it.
+	Notes         string              `json:"notes,omitempty"`
+	Assignee      *UserInfo           `json:"assignee,omitempty"`
+	AssigneeGroup *BaseInfo           `json:"assigneeGroup,omitempty"`
+	Queries       []BaseInfo          `json:"queries,omitempty"`
+	Creator       *UserInfo           `json:"creator,omitempty"`
+	Owner         *UserInfo           `json:"owner,omitempty"`
+	OwnerGroup    *BaseInfo           `json:"ownerGroup,omitempty"`
+	CreatedTime   UnixEpochStringTime `json:"createdTime,omitempty"`
+	ModifiedTime  UnixEpochStringTime `json:"modifiedTime,omitempty"`
+	AssignedTime  UnixEpochStringTime `json:"assignedTime,omitempty"`
+	ResolvedTime  UnixEpochStringTime `json:"resolvedTime,omitempty"`
+	ClosedTime    UnixEpochStringTime `json:"closedTime,omitempty"`
+	CanUse        FakeBool            `json:"canUse,omitempty"`
+	CanManage     FakeBool            `json:"canManage,omitempty"`
+}
+
+// ticketPatch carries partial updates so unrelated fields, possibly changed by others, are not overwritten.
+type ticketPatch struct {
+	Status   TicketStatus `json:"status,omitempty"`
+	Notes    string       `json:"notes,omitempty"`
+	Assignee *UserInfo    `json:"assignee,omitempty"`
+	Queries  []BaseInfo   `json:"queries,omitempty"`
+}
+
+type allTicketsResponse struct {
+	Manageable []*Ticket `json:"manageable" tenable:"recurse"`
+	Usable     []*Ticket `json:"usable" tenable:"recurse"`
+}
+
+func (o allTicketsResponse) allTicketsToExternal() []*Ticket {
+	var spOut []*Ticket
+	spMap := make(map[ProbablyString]bool)
+
+	for _, o := range o.Usable {
+		spOut = append(spOut, o)
+		spMap[o.ID] = true
+	}
+	for _, o := range o.Manageable {
+		if _, exists := spMap[o.ID]; !exists {
+			spOut = append(spOut, o)
+			spMap[o.ID] = true
+		}
+	}
+
+	return spOut
+}
+
+func (c *Client) GetAllTickets() ([]*Ticket, error) {
+	var resp allTicketsResponse
+
+	if _, err := c.getResource(ticketEndpoint, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get tickets: %w", err)
+	}
+
+	return resp.allTicketsToExternal(), nil
+}
+
+func (c *Client) GetTicket(id string) (*Ticket, error) {
+	resp := &Ticket{}
+
+	if _, err := c.getResource(fmt.Sprintf("%s/%s", ticketEndpoint, id), resp); err != nil {
+		return nil, fmt.Errorf("failed to get ticket id %s: %w", id, err)
+	}
+
+	return resp, nil
+}
+
+// CreateTicket opens a new ticket; Name and Assignee are required.
+func (c *Client) CreateTicket(t *Ticket) (*Ticket, error) {
+	resp := &Ticket{}
+
+	if _, err := c.postResource(ticketEndpoint, t, resp); err != nil {
+		return nil, fmt.Errorf("failed to create ticket: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) UpdateTicket(t *Ticket) (*Ticket, error) {
+	resp := &Ticket{}
+
+	if _, err := c.patchResourceWithID(ticketEndpoint, t, resp); err != nil {
+		return nil, fmt.Errorf("failed to update ticket: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) patchTicket(id string, patch *ticketPatch) (*Ticket, error) {
+	resp := &Ticket{}
+
+	if _, err := c.patchResource(fmt.Sprintf("%s/%s", ticketEndpoint, id), patch, resp); err != nil {
+		return nil, fmt.Errorf("failed to update ticket %s: %w", id, err)
+	}
+
+	return resp, nil
+}
+
+// AssignTicket assigns ticket {id} to user {assigneeID}, reopening it if it was resolved or closed.
+func (c *Client) AssignTicket(id, assigneeID string) (*Ticket, error) {
+	return c.patchTicket(id, &ticketPatch{
+		Status:   TicketStatusAssigned,
+		Assignee: &UserInfo{ID: ProbablyString(assigneeID)},
+	})
+}
+
+// SetTicketStatus moves ticket {id} to status, appending note to the ticket notes if it is not empty.
+//
+//	The existing notes are re-read immediately before the update; SC rejects transitions it does not allow.
+func (c *Client) SetTicketStatus(id string, status TicketStatus, note string) (*Ticket, error) {
+	t, err := c.GetTicket(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.patchTicket(id, &ticketPatch{
+		Status: status,
+		Notes:  appendTicketNote(t.Notes, note),
+	})
+}
+
+// ResolveTicket marks ticket {id} resolved, recording note.
+func (c *Client) ResolveTicket(id, note string) (*Ticket, error) {
+	return c.SetTicketStatus(id, TicketStatusResolved, note)
+}
+
+// CloseTicket closes ticket {id}, recording note.
+func (c *Client) CloseTicket(id, note string) (*Ticket, error) {
+	return c.SetTicketStatus(id, TicketStatusClosed, note)
+}
+
+func appendTicketNote(notes, note string) string {
+	note = strings.TrimSpace(note)
+	switch {
+	case note == "":
+		return notes
+	case notes == "":
+		return note
+	}
+	return notes + "\n" + note
+}
+
+// AddTicketNote appends note to the notes of ticket {id}; the existing notes are re-read immediately before the update.
+func (c *Client) AddTicketNote(id, note string) (*Ticket, error) {
+	t, err := c.GetTicket(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.patchTicket(id, &ticketPatch{Notes: appendTicketNote(t.Notes, note)})
+}
+
+// AttachTicketQueries adds the given saved queries to ticket {id}, keeping any already attached.
+func (c *Client) AttachTicketQueries(id string, queryIDs ...string) (*Ticket, error) {
+	t, err := c.GetTicket(id)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := t.Queries
+	attached := make(map[ProbablyString]bool, len(queries))
+	for _, q := range queries {
+		attached[q.ID] = true
+	}
+	for _, qID := range queryIDs {
+		if !attached[ProbablyString(qID)] {
+			queries = append(queries, BaseInfo{ID: ProbablyString(qID)})
+			attached[ProbablyString(qID)] = true
+		}
+	}
+
+	if len(queries) == len(t.Queries) {
+		return t, nil
+	}
+
+	return c.patchTicket(id, &ticketPatch{Queries: queries})
+}
@@ -0,0 +1,55 @@
+package tenablesc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendTicketNote(t *testing.T) {
+	assert.Equal(t, "", appendTicketNote("", ""))
+	assert.Equal(t, "first", appendTicketNote("", " first \n"))
+	assert.Equal(t, "first", appendTicketNote("first", "  "))
+	assert.Equal(t, "first\nsecond", appendTicketNote("first", "second"))
+}
+
+func TestSetTicketStatus(t *testing.T) {
+	current := Ticket{BaseInfo: BaseInfo{ID: "3"}, Status: TicketStatusClosed, Notes: "opened"}
+
+	var patches []ticketPatch
+	c := newStubClient(t, func(r *http.Request) interface{} {
+		if r.Method == http.MethodPatch {
+			var patch ticketPatch
+			if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+				return err
+			}
+			patches = append(patches, patch)
+			if patch.Status == TicketStatusFeedback {
+				return errors.New("invalid status transition")
+			}
+			current.Status = patch.Status
+			current.Notes = patch.Notes
+		}
+		return current
+	})
+
+	// Transitions are left to SC to validate.
+	ticket, err := c.ResolveTicket("3", "fixed")
+	if assert.NoError(t, err) {
+		assert.Equal(t, TicketStatusResolved, ticket.Status)
+		assert.Equal(t, "opened\nfixed", ticket.Notes)
+	}
+
+	_, err = c.SetTicketStatus("3", TicketStatusFeedback, "")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid status transition")
+	}
+
+	assert.Equal(t, []ticketPatch{
+		{Status: TicketStatusResolved, Notes: "opened\nfixed"},
+		{Status: TicketStatusFeedback, Notes: "opened\nfixed"},
+	}, patches)
+}